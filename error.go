@@ -37,6 +37,13 @@ func (e Error) Error() string {
 	errName := e.Name
 	if len(e.Path) > 0 {
 		errName = strings.Join(append(e.Path, e.Name), ".")
+		if e.Name == "" {
+			errName = strings.Join(e.Path, ".")
+		}
+	}
+	if errName == "" {
+		// struct-level errors (see RegisterStructValidationFn) don't belong to a field
+		return e.Err.Error()
 	}
 
 	return errName + ": " + e.Err.Error()
@@ -19,6 +19,8 @@ func TestErrorsToString(t *testing.T) {
 		{Errors{fmt.Errorf("Error 1"), fmt.Errorf("Error 2")}, "Error 1;Error 2"},
 		{Errors{customErr, fmt.Errorf("Error 2")}, "Custom Error Name: stdlib error;Error 2"},
 		{Errors{fmt.Errorf("Error 123"), customErrWithCustomErrorMessage}, "Error 123;Bad stuff happened"},
+		{Errors{Error{Err: fmt.Errorf("struct error")}}, "struct error"},
+		{Errors{Error{Err: fmt.Errorf("struct error"), Path: []string{"Outer", "Inner"}}}, "Outer.Inner: struct error"},
 	}
 	for _, test := range tests {
 		actual := test.param1.Error()
@@ -0,0 +1,119 @@
+//go:build ignore
+// +build ignore
+
+// gen_tlds.go generates tlds.go from the ICANN section of the public suffix list: every
+// top-level label of a rule in that section is a top-level domain.
+//
+// Run `go generate` to fetch the list from https://publicsuffix.org/list/public_suffix_list.dat,
+// or `go run gen_tlds.go [-version label] <file>` to use a local copy, e.g. the one shipped by the
+// publicsuffix package of most Linux distributions (/usr/share/publicsuffix/public_suffix_list.dat).
+// The version recorded in tlds.go is taken from the "// VERSION:" line of the list, or from -version
+// for copies without one.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+const pslURL = "https://publicsuffix.org/list/public_suffix_list.dat"
+
+func main() {
+	version := flag.String("version", "", "version of the list, if it has no \"// VERSION:\" line")
+	flag.Parse()
+
+	var r io.Reader
+	if flag.NArg() > 0 {
+		f, err := os.Open(flag.Arg(0))
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		r = f
+	} else {
+		resp, err := http.Get(pslURL)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("fetching %s: %s", pslURL, resp.Status)
+		}
+		r = resp.Body
+	}
+
+	tlds := make(map[string]struct{})
+	icann := false
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "// VERSION:"):
+			if *version == "" {
+				*version = strings.TrimSpace(strings.TrimPrefix(line, "// VERSION:"))
+			}
+		case line == "// ===BEGIN ICANN DOMAINS===":
+			icann = true
+		case line == "// ===END ICANN DOMAINS===":
+			icann = false
+		case icann && line != "" && !strings.HasPrefix(line, "//"):
+			labels := strings.Split(line, ".")
+			tld, err := idna.ToASCII(labels[len(labels)-1])
+			if err != nil {
+				log.Fatalf("%s: %v", line, err)
+			}
+			tlds[strings.ToLower(tld)] = struct{}{}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	if len(tlds) == 0 {
+		log.Fatal("no ICANN section found in the public suffix list")
+	}
+	if *version == "" {
+		log.Fatal("the list has no \"// VERSION:\" line, pass -version")
+	}
+
+	sorted := make([]string, 0, len(tlds))
+	for tld := range tlds {
+		sorted = append(sorted, tld)
+	}
+	sort.Strings(sorted)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_tlds.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package govalidator\n\n")
+	cmd := "go generate"
+	if len(os.Args) > 1 {
+		cmd = "go run gen_tlds.go " + strings.Join(os.Args[1:], " ")
+	}
+	buf.WriteString("// tldList contains the top-level domains checked by IsTLDValid: the top-level labels of the rules in\n")
+	fmt.Fprintf(&buf, "// the ICANN section of the public suffix list, version %s (see %s).\n", *version, pslURL)
+	fmt.Fprintf(&buf, "// It was generated with `%s`; see gen_tlds.go.\n", cmd)
+	buf.WriteString("// Internationalized TLDs are stored in their punycode form. Use UpdateTLDList to refresh it at runtime.\n")
+	buf.WriteString("var tldList = map[string]struct{}{\n")
+	for _, tld := range sorted {
+		fmt.Fprintf(&buf, "\t%q: {},\n", tld)
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("tlds.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,13 @@
+module github.com/asaskevich/govalidator
+
+go 1.18
+
+require (
+	github.com/andybalholm/cascadia v1.3.1
+	github.com/antchfx/xpath v1.3.8
+	github.com/jmespath/go-jmespath v0.4.0
+	github.com/titanous/json5 v1.0.0
+	golang.org/x/text v0.14.0
+)
+
+require golang.org/x/net v0.0.0-20210916014120-12bc252f5db8 // indirect
@@ -8,9 +8,12 @@ const (
     CreditCard        string = "^(?:4[0-9]{12}(?:[0-9]{3})?|5[1-5][0-9]{14}|6(?:011|5[0-9][0-9])[0-9]{12}|3[47][0-9]{13}|3(?:0[0-5]|[68][0-9])[0-9]{11}|(?:2131|1800|35\\d{3})\\d{11})$"
     ISBN10            string = "^(?:[0-9]{9}X|[0-9]{10})$"
     ISBN13            string = "^(?:[0-9]{13})$"
+    UUID1             string = "^[0-9a-f]{8}-[0-9a-f]{4}-1[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
     UUID3             string = "^[0-9a-f]{8}-[0-9a-f]{4}-3[0-9a-f]{3}-[0-9a-f]{4}-[0-9a-f]{12}$"
     UUID4             string = "^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
     UUID5             string = "^[0-9a-f]{8}-[0-9a-f]{4}-5[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
+    UUID6             string = "^[0-9a-f]{8}-[0-9a-f]{4}-6[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
+    UUID7             string = "^[0-9a-f]{8}-[0-9a-f]{4}-7[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
     UUID              string = "^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$"
     Alpha             string = "^[a-zA-Z]+$"
     Alphanumeric      string = "^[a-zA-Z0-9]+$"
@@ -42,6 +45,57 @@ const (
     WinPath           string = `^[a-zA-Z]:\\(?:[^\\/:*?"<>|\r\n]+\\)*[^\\/:*?"<>|\r\n]*$`
     UnixPath          string = `^(/[^/\x00]*)+/?$`
     Semver            string = "^v?(?:0|[1-9]\\d*)\\.(?:0|[1-9]\\d*)\\.(?:0|[1-9]\\d*)(-(0|[1-9]\\d*|\\d*[a-zA-Z-][0-9a-zA-Z-]*)(\\.(0|[1-9]\\d*|\\d*[a-zA-Z-][0-9a-zA-Z-]*))*)?(\\+[0-9a-zA-Z-]+(\\.[0-9a-zA-Z-]+)*)?$"
+    EnvVarName        string = "^[A-Z_][A-Z0-9_]*$"
+    CustomElementName string = "^[a-z][-.0-9_a-z\\x{B7}\\x{C0}-\\x{D6}\\x{D8}-\\x{F6}\\x{F8}-\\x{37D}\\x{37F}-\\x{1FFF}\\x{200C}-\\x{200D}\\x{203F}-\\x{2040}\\x{2070}-\\x{218F}\\x{2C00}-\\x{2FEF}\\x{3001}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFFD}\\x{10000}-\\x{EFFFF}]*$"
+    LDAPAttrType      string = `^([a-zA-Z][a-zA-Z0-9-]*|(0|[1-9]\d*)(\.(0|[1-9]\d*))+)$`
+    Base64Image       string = `^data:image/(png|jpeg|gif|webp|svg\+xml);base64,`
+    GRPCMethodPath    string = `^/([a-zA-Z_][a-zA-Z0-9_]*\.)*[a-zA-Z_][a-zA-Z0-9_]*/[a-zA-Z][a-zA-Z0-9]*$`
+    RabbitMQWord      string = `^([a-zA-Z0-9_-]+|\*|#)$`
+    SSHFingerprint    string = `^(MD5:[0-9a-fA-F]{2}(:[0-9a-fA-F]{2}){15}|SHA256:[A-Za-z0-9+/]{43}=?)$`
+    NPMNamePart       string = "^[a-z0-9-][a-z0-9._-]*$"
+    GitSCPUser        string = `^[a-zA-Z0-9._-]+$`
+    AzureRGName       string = `^[-\w.()]{0,89}[-\w()]$`
+    AzureNamespace    string = `^[a-zA-Z][a-zA-Z0-9]*(\.[a-zA-Z][a-zA-Z0-9]*)+$`
+    PantonePMS        string = `^(?i)pantone\s+\d{3,4}\s*[CUM]$`
+    PantonePlus       string = `^(?i)pantone\s+P\s+\d{1,3}-\d{1,2}\s*[CU]$`
+    RippleAddress     string = "^r[1-9A-HJ-NP-Za-km-z]{24,33}$"
+    StellarAddress    string = "^G[A-Z2-7]{55}$"
+    EIN               string = `^\d{2}-?\d{7}$`
+    SIN               string = `^[1-9]\d{2}(\d{6}| \d{3} \d{3})$`
+    PascalCase        string = "^[A-Z][a-zA-Z0-9]*$"
+    ORCID             string = `^(https://orcid\.org/)?\d{4}-\d{4}-\d{4}-\d{3}[\dX]$`
+    ISNI              string = `^(\d{15}[\dX]|\d{4} \d{4} \d{4} \d{3}[\dX])$`
+    ArXivID           string = `^(\d{2})(\d{2})\.(\d{4,5})(v[1-9]\d*)?$`
+    ArXivLegacyID     string = `^[a-z]+(-[a-z]+)?(\.[A-Z]{2})?/\d{7}(v[1-9]\d*)?$`
+    SafeS3ObjectKey   string = "^[a-zA-Z0-9._/-]+$"
+    Username          string = "^[a-zA-Z0-9]+(_[a-zA-Z0-9]+)*$"
+    VIN               string = "^[A-HJ-NPR-Z0-9]{17}$"
+    IATAFlightNumber  string = `^([A-Z][A-Z0-9]|[0-9][A-Z]) ?\d{1,4}[A-Z]?$`
+    IATAAirportCode   string = "^[A-Z]{3}$"
+    NPI               string = `^[12]\d{9}$`
+    CSSUnit           string = `^[+-]?(\d+(\.\d+)?|\.\d+)(?i:px|pt|pc|in|cm|mm|em|rem|ex|ch|vh|vw|vmin|vmax|fr|%)$`
+    CSSHexColor       string = "^#([0-9a-fA-F]{3,4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$"
+    RGBAcolor         string = `^rgba\(\s*(0|[1-9]\d?|1\d\d?|2[0-4]\d|25[0-5])\s*,\s*(0|[1-9]\d?|1\d\d?|2[0-4]\d|25[0-5])\s*,\s*(0|[1-9]\d?|1\d\d?|2[0-4]\d|25[0-5])\s*,\s*(0|1|0?\.\d+|1\.0+|(100|[1-9]?\d)%)\s*\)$`
+    HSLcolor          string = `^hsla?\(\s*[+-]?\d+(\.\d+)?(deg)?\s*,\s*(100(\.0+)?|[1-9]?\d(\.\d+)?)%\s*,\s*(100(\.0+)?|[1-9]?\d(\.\d+)?)%\s*(,\s*(0|1|0?\.\d+|1\.0+|(100|[1-9]?\d)%)\s*)?\)$`
+    OpenAPIPath       string = `^/([A-Za-z0-9\-._~!$&'()*+,;=:@/]|%[0-9A-Fa-f]{2}|\{[A-Za-z_][A-Za-z0-9_]*\})*$`
+    CSSVariableName   string = `^--([a-zA-Z0-9_-]|[^\x00-\x7F])+$`
+    HTMLAttributeName string = `^[^\x00-\x20\x7F-\x{10FFFF}"'>/=]+$`
+    MySQLIdentifier   string = `^[0-9a-zA-Z$_\x{0080}-\x{FFFF}]+$`
+    SQLiteIdentifier  string = `^[a-zA-Z_][a-zA-Z0-9_$]*$`
+    ISO8601Week       string = `^(\d{4})-W(0[1-9]|[1-4]\d|5[0-3])(-[1-7])?$`
+    Month             string = "^(0?[1-9]|1[0-2])$"
+    Quarter           string = "^[qQ]?[1-4]$"
+    RFC5321DotString  string = "^[a-zA-Z0-9!#$%&'*+/=?^_`{|}~-]+(\\.[a-zA-Z0-9!#$%&'*+/=?^_`{|}~-]+)*$"
+    RFC5321QuotedString string = `^"([\x20\x21\x23-\x5B\x5D-\x7E]|\\[\x20-\x7E])*"$`
+    RFC5321Domain     string = `^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`
+    ABARoutingNumber  string = `^(\d{9}|\d{3}-\d{3}-\d{3})$`
+    SafeGCSObjectPath string = "^[a-zA-Z0-9._~/-]+$"
+    HTTPHeaderName    string = "^[!#$%&'*+.^_`|~0-9A-Za-z-]+$"
+    AcceptLanguageRange string = `^(\*|[a-zA-Z]{1,8}(-[a-zA-Z0-9]{1,8})*)([ \t]*;[ \t]*[qQ]=(0(\.[0-9]{0,3})?|1(\.0{0,3})?))?$`
+    HexadecimalWithPrefix string = "^0[xX][0-9a-fA-F]+$"
+    BinaryDigits      string = "^(0[bB])?[01]+$"
+    DNSLabel          string = `^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`
+    GitHubName        string = "^[a-zA-Z0-9_.-]+$"
     tagName           string = "valid"
     hasLowerCase      string = ".*[[:lower:]]"
     hasUpperCase      string = ".*[[:upper:]]"
@@ -67,9 +121,12 @@ var (
     rxCreditCard          = regexp.MustCompile(CreditCard)
     rxISBN10              = regexp.MustCompile(ISBN10)
     rxISBN13              = regexp.MustCompile(ISBN13)
+    rxUUID1               = regexp.MustCompile(UUID1)
     rxUUID3               = regexp.MustCompile(UUID3)
     rxUUID4               = regexp.MustCompile(UUID4)
     rxUUID5               = regexp.MustCompile(UUID5)
+    rxUUID6               = regexp.MustCompile(UUID6)
+    rxUUID7               = regexp.MustCompile(UUID7)
     rxUUID                = regexp.MustCompile(UUID)
     rxAlpha               = regexp.MustCompile(Alpha)
     rxAlphanumeric        = regexp.MustCompile(Alphanumeric)
@@ -98,4 +155,55 @@ var (
     rxHasUpperCase        = regexp.MustCompile(hasUpperCase)
     rxHasWhitespace       = regexp.MustCompile(hasWhitespace)
     rxHasWhitespaceOnly   = regexp.MustCompile(hasWhitespaceOnly)
+    rxEnvVarName          = regexp.MustCompile(EnvVarName)
+    rxCustomElementName   = regexp.MustCompile(CustomElementName)
+    rxLDAPAttrType        = regexp.MustCompile(LDAPAttrType)
+    rxBase64Image         = regexp.MustCompile(Base64Image)
+    rxGRPCMethodPath      = regexp.MustCompile(GRPCMethodPath)
+    rxRabbitMQWord        = regexp.MustCompile(RabbitMQWord)
+    rxSSHFingerprint      = regexp.MustCompile(SSHFingerprint)
+    rxNPMNamePart         = regexp.MustCompile(NPMNamePart)
+    rxGitSCPUser          = regexp.MustCompile(GitSCPUser)
+    rxAzureRGName         = regexp.MustCompile(AzureRGName)
+    rxAzureNamespace      = regexp.MustCompile(AzureNamespace)
+    rxPantonePMS          = regexp.MustCompile(PantonePMS)
+    rxPantonePlus         = regexp.MustCompile(PantonePlus)
+    rxRippleAddress       = regexp.MustCompile(RippleAddress)
+    rxStellarAddress      = regexp.MustCompile(StellarAddress)
+    rxEIN                 = regexp.MustCompile(EIN)
+    rxSIN                 = regexp.MustCompile(SIN)
+    rxPascalCase          = regexp.MustCompile(PascalCase)
+    rxORCID               = regexp.MustCompile(ORCID)
+    rxISNI                = regexp.MustCompile(ISNI)
+    rxArXivID             = regexp.MustCompile(ArXivID)
+    rxArXivLegacyID       = regexp.MustCompile(ArXivLegacyID)
+    rxSafeS3ObjectKey     = regexp.MustCompile(SafeS3ObjectKey)
+    rxUsername            = regexp.MustCompile(Username)
+    rxVIN                 = regexp.MustCompile(VIN)
+    rxIATAFlightNumber    = regexp.MustCompile(IATAFlightNumber)
+    rxIATAAirportCode     = regexp.MustCompile(IATAAirportCode)
+    rxNPI                 = regexp.MustCompile(NPI)
+    rxCSSUnit             = regexp.MustCompile(CSSUnit)
+    rxCSSHexColor         = regexp.MustCompile(CSSHexColor)
+    rxRGBAcolor           = regexp.MustCompile(RGBAcolor)
+    rxHSLcolor            = regexp.MustCompile(HSLcolor)
+    rxOpenAPIPath         = regexp.MustCompile(OpenAPIPath)
+    rxCSSVariableName     = regexp.MustCompile(CSSVariableName)
+    rxHTMLAttributeName   = regexp.MustCompile(HTMLAttributeName)
+    rxMySQLIdentifier     = regexp.MustCompile(MySQLIdentifier)
+    rxSQLiteIdentifier    = regexp.MustCompile(SQLiteIdentifier)
+    rxISO8601Week         = regexp.MustCompile(ISO8601Week)
+    rxMonth               = regexp.MustCompile(Month)
+    rxQuarter             = regexp.MustCompile(Quarter)
+    rxRFC5321DotString    = regexp.MustCompile(RFC5321DotString)
+    rxRFC5321QuotedString = regexp.MustCompile(RFC5321QuotedString)
+    rxRFC5321Domain       = regexp.MustCompile(RFC5321Domain)
+    rxABARoutingNumber    = regexp.MustCompile(ABARoutingNumber)
+    rxSafeGCSObjectPath   = regexp.MustCompile(SafeGCSObjectPath)
+    rxHTTPHeaderName      = regexp.MustCompile(HTTPHeaderName)
+    rxAcceptLanguageRange = regexp.MustCompile(AcceptLanguageRange)
+    rxHexadecimalWithPrefix = regexp.MustCompile(HexadecimalWithPrefix)
+    rxBinaryDigits        = regexp.MustCompile(BinaryDigits)
+    rxDNSLabel            = regexp.MustCompile(DNSLabel)
+    rxGitHubName          = regexp.MustCompile(GitHubName)
 )
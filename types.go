@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"sort"
 	"sync"
+	"unicode"
 )
 
 // Validator is a wrapper for a validator function that returns bool and accepts string.
@@ -14,8 +15,17 @@ type Validator func(str string) bool
 // The second parameter should be the context (in the case of validating a struct: the whole object being validated).
 type CustomTypeValidator func(i interface{}, o interface{}) bool
 
+// CustomTypeValidatorWithError is a CustomTypeValidator that can also explain why a value is invalid:
+// a non-nil error fails the validation and is reported as the field's error (unless the tag sets
+// a custom error message). Register it with CustomTypeTagMap.SetWithError.
+type CustomTypeValidatorWithError func(i interface{}, o interface{}) (bool, error)
+
 // ParamValidator is a wrapper for validator functions that accepts additional parameters.
 type ParamValidator func(str string, params ...string) bool
+
+// StructValidationFn is a wrapper for functions validating a whole struct, e.g. constraints spanning multiple fields.
+// It is called with the struct value being validated and should return a non-nil error if the struct is invalid.
+type StructValidationFn func(s interface{}) error
 type tagOptionsMap map[string]tagOption
 
 func (t tagOptionsMap) orderedKeys() []string {
@@ -48,28 +58,57 @@ type stringValues []reflect.Value
 
 // ParamTagMap is a map of functions accept variants parameters
 var ParamTagMap = map[string]ParamValidator{
-	"length":       ByteLength,
-	"range":        Range,
-	"runelength":   RuneLength,
-	"stringlength": StringLength,
-	"matches":      StringMatches,
-	"in":           isInRaw,
-	"rsapub":       IsRsaPub,
+	"length":          ByteLength,
+	"range":           Range,
+	"runelength":      RuneLength,
+	"stringlength":    StringLength,
+	"matches":         StringMatches,
+	"in":              isInRaw,
+	"rsapub":          IsRsaPub,
+	"otpcode":         IsOTPCode,
+	"pwcharsets":      IsPasswordContainsCharsets,
+	"noconsecutive":   IsNoConsecutiveRepeatedChars,
+	"iprange":         IsIPInRange,
+	"username":        IsUsername,
+	"datauriwithmime": IsDataURIWithMIME,
+	"hexlength":       IsHexLength,
+	"dbtablename":     IsDatabaseTableName,
+	"agerange":        IsAgeRange,
+	"minage":          IsMinAge,
+	"maxage":          IsMaxAge,
+	"unicodeblock":    IsUnicodeBlock,
+	"urlwithscheme":   IsURLWithScheme,
+	"pwpolicy":        IsPasswordPolicy,
 }
 
 // ParamTagRegexMap maps param tags to their respective regexes.
 var ParamTagRegexMap = map[string]*regexp.Regexp{
-	"range":        regexp.MustCompile("^range\\((\\d+)\\|(\\d+)\\)$"),
-	"length":       regexp.MustCompile("^length\\((\\d+)\\|(\\d+)\\)$"),
-	"runelength":   regexp.MustCompile("^runelength\\((\\d+)\\|(\\d+)\\)$"),
-	"stringlength": regexp.MustCompile("^stringlength\\((\\d+)\\|(\\d+)\\)$"),
-	"in":           regexp.MustCompile(`^in\((.*)\)`),
-	"matches":      regexp.MustCompile(`^matches\((.+)\)$`),
-	"rsapub":       regexp.MustCompile("^rsapub\\((\\d+)\\)$"),
+	"range":           regexp.MustCompile("^range\\((\\d+)\\|(\\d+)\\)$"),
+	"length":          regexp.MustCompile("^length\\((\\d+)\\|(\\d+)\\)$"),
+	"runelength":      regexp.MustCompile("^runelength\\((\\d+)\\|(\\d+)\\)$"),
+	"stringlength":    regexp.MustCompile("^stringlength\\((\\d+)\\|(\\d+)\\)$"),
+	"in":              regexp.MustCompile(`^in\((.*)\)`),
+	"matches":         regexp.MustCompile(`^matches\((.+)\)$`),
+	"rsapub":          regexp.MustCompile("^rsapub\\((\\d+)\\)$"),
+	"otpcode":         regexp.MustCompile(`^otpcode(\((\d+)\))?$`),
+	"pwcharsets":      regexp.MustCompile(`^pwcharsets\(([a-z|]+)\)$`),
+	"noconsecutive":   regexp.MustCompile(`^noconsecutive\((\d+)\)$`),
+	"iprange":         regexp.MustCompile(`^iprange\(([^)]+)\)$`),
+	"username":        regexp.MustCompile(`^username\((\d+)\|(\d+)\)$`),
+	"datauriwithmime": regexp.MustCompile(`^datauriwithmime\(([^)]+)\)$`),
+	"hexlength":       regexp.MustCompile(`^hexlength\((\d+)\)$`),
+	"dbtablename":     regexp.MustCompile(`^dbtablename\((\w+)\)$`),
+	"agerange":        regexp.MustCompile(`^agerange\((\d+)\|(\d+)\)$`),
+	"minage":          regexp.MustCompile(`^minage\((\d+)\)$`),
+	"maxage":          regexp.MustCompile(`^maxage\((\d+)\)$`),
+	"unicodeblock":    regexp.MustCompile(`^unicodeblock\((\w+)\)$`),
+	"urlwithscheme":   regexp.MustCompile(`^urlwithscheme\(([a-zA-Z][a-zA-Z0-9+.|-]*)\)$`),
+	"pwpolicy":        regexp.MustCompile(`^pwpolicy\(([^)]+)\)$`),
 }
 
 type customTypeTagMap struct {
-	validators map[string]CustomTypeValidator
+	validators          map[string]CustomTypeValidator
+	validatorsWithError map[string]CustomTypeValidatorWithError
 
 	sync.RWMutex
 }
@@ -77,20 +116,78 @@ type customTypeTagMap struct {
 func (tm *customTypeTagMap) Get(name string) (CustomTypeValidator, bool) {
 	tm.RLock()
 	defer tm.RUnlock()
-	v, ok := tm.validators[name]
-	return v, ok
+	if v, ok := tm.validators[name]; ok {
+		return v, true
+	}
+	if v, ok := tm.validatorsWithError[name]; ok {
+		return func(i interface{}, o interface{}) bool {
+			valid, err := v(i, o)
+			return valid && err == nil
+		}, true
+	}
+	return nil, false
 }
 
 func (tm *customTypeTagMap) Set(name string, ctv CustomTypeValidator) {
 	tm.Lock()
 	defer tm.Unlock()
+	delete(tm.validatorsWithError, name)
 	tm.validators[name] = ctv
 }
 
+// GetWithError returns the validator registered under name, wrapping a CustomTypeValidator
+// so that it never returns an error.
+func (tm *customTypeTagMap) GetWithError(name string) (CustomTypeValidatorWithError, bool) {
+	tm.RLock()
+	defer tm.RUnlock()
+	if v, ok := tm.validatorsWithError[name]; ok {
+		return v, true
+	}
+	if v, ok := tm.validators[name]; ok {
+		return func(i interface{}, o interface{}) (bool, error) {
+			return v(i, o), nil
+		}, true
+	}
+	return nil, false
+}
+
+// SetWithError registers a validator that returns an error describing why the value is invalid.
+func (tm *customTypeTagMap) SetWithError(name string, ctv CustomTypeValidatorWithError) {
+	tm.Lock()
+	defer tm.Unlock()
+	delete(tm.validators, name)
+	tm.validatorsWithError[name] = ctv
+}
+
 // CustomTypeTagMap is a map of functions that can be used as tags for ValidateStruct function.
 // Use this to validate compound or custom types that need to be handled as a whole, e.g.
 // `type UUID [16]byte` (this would be handled as an array of bytes).
-var CustomTypeTagMap = &customTypeTagMap{validators: make(map[string]CustomTypeValidator)}
+var CustomTypeTagMap = &customTypeTagMap{
+	validators:          make(map[string]CustomTypeValidator),
+	validatorsWithError: make(map[string]CustomTypeValidatorWithError),
+}
+
+type structValidationFnMap struct {
+	fns map[reflect.Type]StructValidationFn
+
+	sync.RWMutex
+}
+
+func (fm *structValidationFnMap) Get(t reflect.Type) (StructValidationFn, bool) {
+	fm.RLock()
+	defer fm.RUnlock()
+	fn, ok := fm.fns[t]
+	return fn, ok
+}
+
+func (fm *structValidationFnMap) Set(t reflect.Type, fn StructValidationFn) {
+	fm.Lock()
+	defer fm.Unlock()
+	fm.fns[t] = fn
+}
+
+// structValidationFns holds the functions registered with RegisterStructValidationFn.
+var structValidationFns = &structValidationFnMap{fns: make(map[reflect.Type]StructValidationFn)}
 
 // TagMap is a map of functions, that can be used as tags for ValidateStruct function.
 var TagMap = map[string]Validator{
@@ -115,10 +212,14 @@ var TagMap = map[string]Validator{
 	"float":              IsFloat,
 	"null":               IsNull,
 	"uuid":               IsUUID,
+	"uuidv1":             IsUUIDv1,
 	"uuidv3":             IsUUIDv3,
 	"uuidv4":             IsUUIDv4,
 	"uuidv5":             IsUUIDv5,
+	"uuidv6":             IsUUIDv6,
+	"uuidv7":             IsUUIDv7,
 	"creditcard":         IsCreditCard,
+	"isbn":               isISBNAny,
 	"isbn10":             IsISBN10,
 	"isbn13":             IsISBN13,
 	"json":               IsJSON,
@@ -145,7 +246,93 @@ var TagMap = map[string]Validator{
 	"rfc3339WithoutZone": IsRFC3339WithoutZone,
 	"ISO3166Alpha2":      IsISO3166Alpha2,
 	"ISO3166Alpha3":      IsISO3166Alpha3,
+	"ISO3166Numeric":     IsISO3166Numeric,
 	"ISO4217":            IsISO4217,
+	"esindex":            IsElasticsearchIndexName,
+	"goidentifier":       IsGoIdentifier,
+	"envvar":             IsEnvironmentVariableName,
+	"htmltag":            IsHTMLTagName,
+	"xmlncname":          IsXMLNCName,
+	"ldapdn":             IsLDAPDN,
+	"nfc":                IsNFCNormalized,
+	"nfd":                IsNFDNormalized,
+	"nfkc":               IsNFKCNormalized,
+	"nfkd":               IsNFKDNormalized,
+	"base64image":        IsBase64Image,
+	"singleline":         IsSingleLine,
+	"noduplicatewords":   IsNoDuplicateWords,
+	"json5":              IsJSON5,
+	"ndjson":             IsNDJSON,
+	"jmespath":           IsJMESPath,
+	"xpath":              IsXPathExpression,
+	"grpcmethod":         IsGRPCMethodPath,
+	"ecdsapub":           IsECDSAPublicKey,
+	"ed25519pub":         IsED25519PublicKey,
+	"upc":                IsUPCBarcode,
+	"cssselector":        IsCSSSelector,
+	"rabbitmqkey":        IsRabbitMQRoutingKey,
+	"neo4juri":           IsNeo4jConnectionURI,
+	"utf8valid":          IsValidUTF8,
+	"jwtalg":             IsJWTAlgorithm,
+	"sshfingerprint":     IsSSHKeyFingerprint,
+	"npmpackage":         IsNPMPackageName,
+	"gitremote":          IsGitRemoteURL,
+	"azureresource":      IsAzureResourceID,
+	"pantone":            IsPantoneColor,
+	"xrpaddress":         IsRippleAddress,
+	"stellaraddress":     IsStellarAddress,
+	"bomfree":            IsBOMFree,
+	"ein":                IsEIN,
+	"sin":                IsSIN,
+	"multicastip":        IsMulticastIP,
+	"pgidentifier":       IsPostgresIdentifier,
+	"jsonmergepatch":     IsJSONMergePatch,
+	"pascalcase":         IsPascalCase,
+	"gtin":               IsGTIN,
+	"orcid":              IsORCIDID,
+	"isni":               IsISNI,
+	"arxiv":              IsARXIV,
+	"pmid":               IsPMID,
+	"noemoji":            IsNoEmoji,
+	"s3key":              IsS3ObjectKey,
+	"ipv4mapped":         IsIPv4MappedIPv6,
+	"vin":                IsVIN,
+	"iataflightnumber":   IsIATAFlightNumber,
+	"iataairport":        IsIATAAirportCode,
+	"npi":                IsNPI,
+	"htmlsafe":           IsHTMLSafe,
+	"cssunit":            IsCSSUnit,
+	"color":              IsColor,
+	"openapipath":        IsOpenAPIPath,
+	"cssvar":             IsCSSVariableName,
+	"htmlattr":           IsHTMLAttributeName,
+	"wsurl":              IsWSSURL,
+	"emaillist":          IsMultipleEmails,
+	"hostnameport":       IsHostnamePort,
+	"dob":                IsDateOfBirth,
+	"workingday":         IsWorkingDay,
+	"weekend":            IsWeekend,
+	"iso8601week":        IsISO8601Week,
+	"month":              IsMonth,
+	"quarter":            IsQuarter,
+	"nocontrol":          IsNoControlChars,
+	"countrycode":        IsCountryCode,
+	"languagecode":       IsLanguageCode,
+	"imei":               IsIMEI,
+	"rfc5321email":       IsRFC5321Mailbox,
+	"abarouting":         IsBankRoutingNumber,
+	"nondisposableemail": IsNonDisposableEmail,
+	"gcsobject":          IsGCSObjectPath,
+	"httpheadername":     IsHTTPHeaderName,
+	"acceptlanguage":     IsAcceptLanguage,
+	"jsonschema":         IsJSONSchema,
+	"svg":                IsSVGString,
+	"tld":                IsTLDValid,
+	"0xhex":              IsHexadecimalWithPrefix,
+	"binary":             IsBinaryDigits,
+	"fullurl":            IsFullURL,
+	"subdomain":          IsSubdomain,
+	"githuburl":          IsGitHubURL,
 }
 
 // ISO3166Entry stores country codes
@@ -634,3 +821,222 @@ var ISO693List = []ISO693Entry{
 	{Alpha3bCode: "zha", Alpha2Code: "za", English: "Zhuang; Chuang"},
 	{Alpha3bCode: "zul", Alpha2Code: "zu", English: "Zulu"},
 }
+
+// HTMLTagList is the list of standard HTML5 element names
+var HTMLTagList = map[string]struct{}{
+	"a": {}, "abbr": {}, "address": {}, "area": {}, "article": {}, "aside": {}, "audio": {},
+	"b": {}, "base": {}, "bdi": {}, "bdo": {}, "blockquote": {}, "body": {}, "br": {}, "button": {},
+	"canvas": {}, "caption": {}, "cite": {}, "code": {}, "col": {}, "colgroup": {},
+	"data": {}, "datalist": {}, "dd": {}, "del": {}, "details": {}, "dfn": {}, "dialog": {}, "div": {}, "dl": {}, "dt": {},
+	"em": {}, "embed": {},
+	"fieldset": {}, "figcaption": {}, "figure": {}, "footer": {}, "form": {},
+	"h1": {}, "h2": {}, "h3": {}, "h4": {}, "h5": {}, "h6": {}, "head": {}, "header": {}, "hgroup": {}, "hr": {}, "html": {},
+	"i": {}, "iframe": {}, "img": {}, "input": {}, "ins": {},
+	"kbd":   {},
+	"label": {}, "legend": {}, "li": {}, "link": {},
+	"main": {}, "map": {}, "mark": {}, "math": {}, "menu": {}, "meta": {}, "meter": {},
+	"nav": {}, "noscript": {},
+	"object": {}, "ol": {}, "optgroup": {}, "option": {}, "output": {},
+	"p": {}, "param": {}, "picture": {}, "pre": {}, "progress": {},
+	"q":  {},
+	"rp": {}, "rt": {}, "ruby": {},
+	"s": {}, "samp": {}, "script": {}, "search": {}, "section": {}, "select": {}, "slot": {}, "small": {}, "source": {},
+	"span": {}, "strong": {}, "style": {}, "sub": {}, "summary": {}, "sup": {}, "svg": {},
+	"table": {}, "tbody": {}, "td": {}, "template": {}, "textarea": {}, "tfoot": {}, "th": {}, "thead": {}, "time": {},
+	"title": {}, "tr": {}, "track": {},
+	"u": {}, "ul": {},
+	"var": {}, "video": {},
+	"wbr": {},
+}
+
+// ValidJWTAlgorithms is the list of "alg" header parameter values registered in the
+// IANA JSON Web Signature and Encryption Algorithms registry
+var ValidJWTAlgorithms = map[string]struct{}{
+	// JWS
+	"HS256": {}, "HS384": {}, "HS512": {},
+	"RS256": {}, "RS384": {}, "RS512": {},
+	"ES256": {}, "ES384": {}, "ES512": {}, "ES256K": {},
+	"PS256": {}, "PS384": {}, "PS512": {},
+	"EdDSA": {}, "Ed25519": {}, "Ed448": {},
+	"none": {},
+	// JWE key management
+	"RSA1_5": {}, "RSA-OAEP": {}, "RSA-OAEP-256": {}, "RSA-OAEP-384": {}, "RSA-OAEP-512": {},
+	"A128KW": {}, "A192KW": {}, "A256KW": {},
+	"dir":     {},
+	"ECDH-ES": {}, "ECDH-ES+A128KW": {}, "ECDH-ES+A192KW": {}, "ECDH-ES+A256KW": {},
+	"A128GCMKW": {}, "A192GCMKW": {}, "A256GCMKW": {},
+	"PBES2-HS256+A128KW": {}, "PBES2-HS384+A192KW": {}, "PBES2-HS512+A256KW": {},
+}
+
+// emojiBlocks holds the Unicode blocks reserved for emoji: Miscellaneous Symbols, Dingbats, the regional
+// indicator symbols used for flags, Miscellaneous Symbols and Pictographs, Emoticons, Transport and Map Symbols,
+// Supplemental Symbols and Pictographs and Symbols and Pictographs Extended-A
+var emojiBlocks = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x2600, Hi: 0x27bf, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f1e6, Hi: 0x1f1ff, Stride: 1},
+		{Lo: 0x1f300, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f680, Hi: 0x1f6ff, Stride: 1},
+		{Lo: 0x1f900, Hi: 0x1f9ff, Stride: 1},
+		{Lo: 0x1fa70, Hi: 0x1faff, Stride: 1},
+	},
+}
+
+// cssNamedColors holds the CSS named colors (lowercase), including the transparent and currentcolor keywords
+var cssNamedColors = map[string]struct{}{
+	"aliceblue": {}, "antiquewhite": {}, "aqua": {}, "aquamarine": {}, "azure": {}, "beige": {}, "bisque": {},
+	"black": {}, "blanchedalmond": {}, "blue": {}, "blueviolet": {}, "brown": {}, "burlywood": {}, "cadetblue": {},
+	"chartreuse": {}, "chocolate": {}, "coral": {}, "cornflowerblue": {}, "cornsilk": {}, "crimson": {}, "cyan": {},
+	"darkblue": {}, "darkcyan": {}, "darkgoldenrod": {}, "darkgray": {}, "darkgreen": {}, "darkgrey": {},
+	"darkkhaki": {}, "darkmagenta": {}, "darkolivegreen": {}, "darkorange": {}, "darkorchid": {}, "darkred": {},
+	"darksalmon": {}, "darkseagreen": {}, "darkslateblue": {}, "darkslategray": {}, "darkslategrey": {},
+	"darkturquoise": {}, "darkviolet": {}, "deeppink": {}, "deepskyblue": {}, "dimgray": {}, "dimgrey": {},
+	"dodgerblue": {}, "firebrick": {}, "floralwhite": {}, "forestgreen": {}, "fuchsia": {}, "gainsboro": {},
+	"ghostwhite": {}, "gold": {}, "goldenrod": {}, "gray": {}, "green": {}, "greenyellow": {}, "grey": {},
+	"honeydew": {}, "hotpink": {}, "indianred": {}, "indigo": {}, "ivory": {}, "khaki": {}, "lavender": {},
+	"lavenderblush": {}, "lawngreen": {}, "lemonchiffon": {}, "lightblue": {}, "lightcoral": {}, "lightcyan": {},
+	"lightgoldenrodyellow": {}, "lightgray": {}, "lightgreen": {}, "lightgrey": {}, "lightpink": {},
+	"lightsalmon": {}, "lightseagreen": {}, "lightskyblue": {}, "lightslategray": {}, "lightslategrey": {},
+	"lightsteelblue": {}, "lightyellow": {}, "lime": {}, "limegreen": {}, "linen": {}, "magenta": {}, "maroon": {},
+	"mediumaquamarine": {}, "mediumblue": {}, "mediumorchid": {}, "mediumpurple": {}, "mediumseagreen": {},
+	"mediumslateblue": {}, "mediumspringgreen": {}, "mediumturquoise": {}, "mediumvioletred": {}, "midnightblue": {},
+	"mintcream": {}, "mistyrose": {}, "moccasin": {}, "navajowhite": {}, "navy": {}, "oldlace": {}, "olive": {},
+	"olivedrab": {}, "orange": {}, "orangered": {}, "orchid": {}, "palegoldenrod": {}, "palegreen": {},
+	"paleturquoise": {}, "palevioletred": {}, "papayawhip": {}, "peachpuff": {}, "peru": {}, "pink": {}, "plum": {},
+	"powderblue": {}, "purple": {}, "rebeccapurple": {}, "red": {}, "rosybrown": {}, "royalblue": {},
+	"saddlebrown": {}, "salmon": {}, "sandybrown": {}, "seagreen": {}, "seashell": {}, "sienna": {}, "silver": {},
+	"skyblue": {}, "slateblue": {}, "slategray": {}, "slategrey": {}, "snow": {}, "springgreen": {}, "steelblue": {},
+	"tan": {}, "teal": {}, "thistle": {}, "tomato": {}, "turquoise": {}, "violet": {}, "wheat": {}, "white": {},
+	"whitesmoke": {}, "yellow": {}, "yellowgreen": {}, "transparent": {}, "currentcolor": {},
+}
+
+// postgresReservedWords holds the PostgreSQL key words which are reserved, including those that can only be used
+// as function or type names, and can't be used as unquoted table names
+var postgresReservedWords = map[string]struct{}{
+	"all": {}, "analyse": {}, "analyze": {}, "and": {}, "any": {}, "array": {}, "as": {}, "asc": {}, "asymmetric": {},
+	"both": {}, "case": {}, "cast": {}, "check": {}, "collate": {}, "column": {}, "constraint": {}, "create": {},
+	"current_catalog": {}, "current_date": {}, "current_role": {}, "current_time": {}, "current_timestamp": {},
+	"current_user": {}, "default": {}, "deferrable": {}, "desc": {}, "distinct": {}, "do": {}, "else": {}, "end": {},
+	"except": {}, "false": {}, "fetch": {}, "for": {}, "foreign": {}, "from": {}, "grant": {}, "group": {},
+	"having": {}, "in": {}, "initially": {}, "intersect": {}, "into": {}, "lateral": {}, "leading": {}, "limit": {},
+	"localtime": {}, "localtimestamp": {}, "not": {}, "null": {}, "offset": {}, "on": {}, "only": {}, "or": {},
+	"order": {}, "placing": {}, "primary": {}, "references": {}, "returning": {}, "select": {}, "session_user": {},
+	"some": {}, "symmetric": {}, "table": {}, "then": {}, "to": {}, "trailing": {}, "true": {}, "union": {},
+	"unique": {}, "user": {}, "using": {}, "variadic": {}, "when": {}, "where": {}, "window": {}, "with": {},
+	// reserved (can be function or type)
+	"authorization": {}, "binary": {}, "collation": {}, "concurrently": {}, "cross": {}, "current_schema": {},
+	"freeze": {}, "full": {}, "ilike": {}, "inner": {}, "is": {}, "isnull": {}, "join": {}, "left": {}, "like": {},
+	"natural": {}, "notnull": {}, "outer": {}, "overlaps": {}, "right": {}, "similar": {}, "tablesample": {},
+	"verbose": {},
+}
+
+// mysqlReservedWords holds the MySQL 8.0 reserved words, which can't be used as unquoted table names
+var mysqlReservedWords = map[string]struct{}{
+	"accessible": {}, "add": {}, "all": {}, "alter": {}, "analyze": {}, "and": {}, "as": {}, "asc": {},
+	"asensitive": {}, "before": {}, "between": {}, "bigint": {}, "binary": {}, "blob": {}, "both": {}, "by": {},
+	"call": {}, "cascade": {}, "case": {}, "change": {}, "char": {}, "character": {}, "check": {}, "collate": {},
+	"column": {}, "condition": {}, "constraint": {}, "continue": {}, "convert": {}, "create": {}, "cross": {},
+	"cube": {}, "cume_dist": {}, "current_date": {}, "current_time": {}, "current_timestamp": {}, "current_user": {},
+	"cursor": {}, "database": {}, "databases": {}, "day_hour": {}, "day_microsecond": {}, "day_minute": {},
+	"day_second": {}, "dec": {}, "decimal": {}, "declare": {}, "default": {}, "delayed": {}, "delete": {},
+	"dense_rank": {}, "desc": {}, "describe": {}, "deterministic": {}, "distinct": {}, "distinctrow": {}, "div": {},
+	"double": {}, "drop": {}, "dual": {}, "each": {}, "else": {}, "elseif": {}, "empty": {}, "enclosed": {},
+	"escaped": {}, "except": {}, "exists": {}, "exit": {}, "explain": {}, "false": {}, "fetch": {}, "first_value": {},
+	"float": {}, "float4": {}, "float8": {}, "for": {}, "force": {}, "foreign": {}, "from": {}, "fulltext": {},
+	"function": {}, "generated": {}, "get": {}, "grant": {}, "group": {}, "grouping": {}, "groups": {}, "having": {},
+	"high_priority": {}, "hour_microsecond": {}, "hour_minute": {}, "hour_second": {}, "if": {}, "ignore": {},
+	"in": {}, "index": {}, "infile": {}, "inner": {}, "inout": {}, "insensitive": {}, "insert": {}, "int": {},
+	"int1": {}, "int2": {}, "int3": {}, "int4": {}, "int8": {}, "integer": {}, "intersect": {}, "interval": {},
+	"into": {}, "io_after_gtids": {}, "io_before_gtids": {}, "is": {}, "iterate": {}, "join": {}, "json_table": {},
+	"key": {}, "keys": {}, "kill": {}, "lag": {}, "last_value": {}, "lateral": {}, "lead": {}, "leading": {},
+	"leave": {}, "left": {}, "like": {}, "limit": {}, "linear": {}, "lines": {}, "load": {}, "localtime": {},
+	"localtimestamp": {}, "lock": {}, "long": {}, "longblob": {}, "longtext": {}, "loop": {}, "low_priority": {},
+	"master_bind": {}, "master_ssl_verify_server_cert": {}, "match": {}, "maxvalue": {}, "mediumblob": {},
+	"mediumint": {}, "mediumtext": {}, "middleint": {}, "minute_microsecond": {}, "minute_second": {}, "mod": {},
+	"modifies": {}, "natural": {}, "not": {}, "no_write_to_binlog": {}, "nth_value": {}, "ntile": {}, "null": {},
+	"numeric": {}, "of": {}, "on": {}, "optimize": {}, "optimizer_costs": {}, "option": {}, "optionally": {},
+	"or": {}, "order": {}, "out": {}, "outer": {}, "outfile": {}, "over": {}, "partition": {}, "percent_rank": {},
+	"precision": {}, "primary": {}, "procedure": {}, "purge": {}, "range": {}, "rank": {}, "read": {}, "reads": {},
+	"read_write": {}, "real": {}, "recursive": {}, "references": {}, "regexp": {}, "release": {}, "rename": {},
+	"repeat": {}, "replace": {}, "require": {}, "resignal": {}, "restrict": {}, "return": {}, "revoke": {},
+	"right": {}, "rlike": {}, "row": {}, "row_number": {}, "rows": {}, "schema": {}, "schemas": {},
+	"second_microsecond": {}, "select": {}, "sensitive": {}, "separator": {}, "set": {}, "show": {}, "signal": {},
+	"smallint": {}, "spatial": {}, "specific": {}, "sql": {}, "sqlexception": {}, "sqlstate": {}, "sqlwarning": {},
+	"sql_big_result": {}, "sql_calc_found_rows": {}, "sql_small_result": {}, "ssl": {}, "starting": {}, "stored": {},
+	"straight_join": {}, "system": {}, "table": {}, "terminated": {}, "then": {}, "tinyblob": {}, "tinyint": {},
+	"tinytext": {}, "to": {}, "trailing": {}, "trigger": {}, "true": {}, "undo": {}, "union": {}, "unique": {},
+	"unlock": {}, "unsigned": {}, "update": {}, "usage": {}, "use": {}, "using": {}, "utc_date": {}, "utc_time": {},
+	"utc_timestamp": {}, "values": {}, "varbinary": {}, "varchar": {}, "varcharacter": {}, "varying": {},
+	"virtual": {}, "when": {}, "where": {}, "while": {}, "window": {}, "with": {}, "write": {}, "xor": {},
+	"year_month": {}, "zerofill": {},
+}
+
+// sqliteKeywords holds the SQLite keywords, which need to be quoted when used as table names
+var sqliteKeywords = map[string]struct{}{
+	"abort": {}, "action": {}, "add": {}, "after": {}, "all": {}, "alter": {}, "always": {}, "analyze": {}, "and": {},
+	"as": {}, "asc": {}, "attach": {}, "autoincrement": {}, "before": {}, "begin": {}, "between": {}, "by": {},
+	"cascade": {}, "case": {}, "cast": {}, "check": {}, "collate": {}, "column": {}, "commit": {}, "conflict": {},
+	"constraint": {}, "create": {}, "cross": {}, "current": {}, "current_date": {}, "current_time": {},
+	"current_timestamp": {}, "database": {}, "default": {}, "deferrable": {}, "deferred": {}, "delete": {},
+	"desc": {}, "detach": {}, "distinct": {}, "do": {}, "drop": {}, "each": {}, "else": {}, "end": {}, "escape": {},
+	"except": {}, "exclude": {}, "exclusive": {}, "exists": {}, "explain": {}, "fail": {}, "filter": {}, "first": {},
+	"following": {}, "for": {}, "foreign": {}, "from": {}, "full": {}, "generated": {}, "glob": {}, "group": {},
+	"groups": {}, "having": {}, "if": {}, "ignore": {}, "immediate": {}, "in": {}, "index": {}, "indexed": {},
+	"initially": {}, "inner": {}, "insert": {}, "instead": {}, "intersect": {}, "into": {}, "is": {}, "isnull": {},
+	"join": {}, "key": {}, "last": {}, "left": {}, "like": {}, "limit": {}, "match": {}, "materialized": {},
+	"natural": {}, "no": {}, "not": {}, "nothing": {}, "notnull": {}, "null": {}, "nulls": {}, "of": {}, "offset": {},
+	"on": {}, "or": {}, "order": {}, "others": {}, "outer": {}, "over": {}, "partition": {}, "plan": {}, "pragma": {},
+	"preceding": {}, "primary": {}, "query": {}, "raise": {}, "range": {}, "recursive": {}, "references": {},
+	"regexp": {}, "reindex": {}, "release": {}, "rename": {}, "replace": {}, "restrict": {}, "returning": {},
+	"right": {}, "rollback": {}, "row": {}, "rows": {}, "savepoint": {}, "select": {}, "set": {}, "table": {},
+	"temp": {}, "temporary": {}, "then": {}, "ties": {}, "to": {}, "transaction": {}, "trigger": {}, "unbounded": {},
+	"union": {}, "unique": {}, "update": {}, "using": {}, "vacuum": {}, "values": {}, "view": {}, "virtual": {},
+	"when": {}, "where": {}, "window": {}, "with": {}, "without": {},
+}
+
+type disposableEmailDomainMap struct {
+	domains map[string]struct{}
+
+	sync.RWMutex
+}
+
+func (dm *disposableEmailDomainMap) Has(domain string) bool {
+	dm.RLock()
+	defer dm.RUnlock()
+	_, ok := dm.domains[domain]
+	return ok
+}
+
+func (dm *disposableEmailDomainMap) Add(domain string) {
+	dm.Lock()
+	defer dm.Unlock()
+	dm.domains[domain] = struct{}{}
+}
+
+// disposableEmailDomains holds the domains of disposable email providers checked by IsTemporaryEmail.
+// Use AddDisposableEmailDomain to extend it.
+var disposableEmailDomains = &disposableEmailDomainMap{
+	domains: map[string]struct{}{
+		"10minutemail.com": {}, "10minutemail.net": {}, "20minutemail.com": {}, "33mail.com": {}, "anonbox.net": {},
+		"burnermail.io": {}, "discard.email": {}, "dispostable.com": {}, "dropmail.me": {}, "emailfake.com": {},
+		"emailondeck.com": {}, "fakeinbox.com": {}, "fakemail.net": {}, "fakemailgenerator.com": {},
+		"getairmail.com": {}, "getnada.com": {}, "grr.la": {}, "guerrillamail.biz": {}, "guerrillamail.com": {},
+		"guerrillamail.de": {}, "guerrillamail.info": {}, "guerrillamail.net": {}, "guerrillamail.org": {},
+		"guerrillamailblock.com": {}, "harakirimail.com": {}, "inboxkitten.com": {}, "incognitomail.org": {},
+		"jetable.org": {}, "mailcatch.com": {}, "maildrop.cc": {}, "mailinator.com": {}, "mailinator.net": {},
+		"mailinator2.com": {}, "mailnesia.com": {}, "mailnull.com": {}, "mailpoof.com": {}, "mailsac.com": {},
+		"mintemail.com": {}, "moakt.com": {}, "mohmal.com": {}, "mytemp.email": {}, "mytrashmail.com": {},
+		"nada.email": {}, "sharklasers.com": {}, "spam4.me": {}, "spambog.com": {}, "spamex.com": {},
+		"spamgourmet.com": {}, "temp-mail.io": {}, "temp-mail.org": {}, "tempail.com": {}, "tempinbox.com": {},
+		"tempmail.com": {}, "tempmail.net": {}, "tempmailo.com": {}, "tempr.email": {}, "throwawaymail.com": {},
+		"tmail.ws": {}, "tmpmail.org": {}, "trashmail.com": {}, "trashmail.de": {}, "trashmail.net": {},
+		"yopmail.com": {}, "yopmail.fr": {}, "yopmail.net": {},
+	},
+}
+
+//go:generate go run gen_tlds.go
+
+// tldListMutex guards tldList (see tlds.go), which UpdateTLDList replaces at runtime.
+var tldListMutex sync.RWMutex
@@ -3,12 +3,18 @@ package govalidator
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
 	"encoding/pem"
+	"encoding/xml"
 	"fmt"
+	"go/token"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/url"
@@ -20,6 +26,12 @@ import (
 	"time"
 	"unicode"
 	"unicode/utf8"
+
+	"github.com/andybalholm/cascadia"
+	"github.com/antchfx/xpath"
+	"github.com/jmespath/go-jmespath"
+	"github.com/titanous/json5"
+	"golang.org/x/text/unicode/norm"
 )
 
 var (
@@ -28,12 +40,20 @@ var (
 	notNumberRegexp         = regexp.MustCompile("[^0-9]+")
 	whiteSpacesAndMinus     = regexp.MustCompile(`[\s-]+`)
 	paramsRegexp            = regexp.MustCompile(`\(.*\)$`)
+	requiredIfRegexp        = regexp.MustCompile(`^(required_with_all|required_without_all)\((.+)\)$`)
+	fieldCompareRegexp      = regexp.MustCompile(`^(gtefield|ltefield)\((\w+)\)$`)
 )
 
 const maxURLRuneCount = 2083
 const minURLRuneCount = 3
 const RF3339WithoutZone = "2006-01-02T15:04:05"
 
+// MinDateOfBirthAge and MaxDateOfBirthAge are the bounds of the age in years accepted by IsDateOfBirth.
+const (
+	MinDateOfBirthAge = 0
+	MaxDateOfBirthAge = 150
+)
+
 // SetFieldsRequiredByDefault causes validation to fail when struct fields
 // do not include validations or are not explicitly marked as exempt (using `valid:"-"` or `valid:"email,optional"`).
 // This struct definition will fail govalidator.ValidateStruct() (and the field values do not matter):
@@ -100,6 +120,78 @@ func IsExistingEmail(email string) bool {
 	return true
 }
 
+// IsTemporaryEmail check if the string is an email whose domain (or one of its parent domains) belongs to
+// a known disposable email provider, e.g. user@mailinator.com.
+func IsTemporaryEmail(str string) bool {
+	at := strings.LastIndex(str, "@")
+	if at <= 0 || at == len(str)-1 {
+		return false
+	}
+	domain := strings.TrimSuffix(strings.ToLower(str[at+1:]), ".")
+	for domain != "" {
+		if disposableEmailDomains.Has(domain) {
+			return true
+		}
+		dot := strings.Index(domain, ".")
+		if dot < 0 {
+			break
+		}
+		domain = domain[dot+1:]
+	}
+	return false
+}
+
+// IsNonDisposableEmail check if the string is an email that is not served by a known disposable email provider.
+func IsNonDisposableEmail(str string) bool {
+	return IsEmail(str) && !IsTemporaryEmail(str)
+}
+
+// AddDisposableEmailDomain adds domain to the list of disposable email providers used by IsTemporaryEmail.
+func AddDisposableEmailDomain(domain string) {
+	domain = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+	if domain == "" {
+		return
+	}
+	disposableEmailDomains.Add(domain)
+}
+
+// IsRFC5321Mailbox check if the string is a mailbox as defined by RFC 5321: a dot-atom or quoted string
+// local part (e.g. "john smith"@example.com) and a domain name or an IPv4/IPv6 address literal
+// (e.g. user@[192.168.1.1] or user@[IPv6:2001:db8::1]).
+func IsRFC5321Mailbox(str string) bool {
+	at := strings.LastIndex(str, "@")
+	// the path including angle brackets is limited to 256 octets
+	if at < 1 || len(str) > 254 {
+		return false
+	}
+	local, domain := str[:at], str[at+1:]
+	if len(local) > 64 || len(domain) > 255 {
+		return false
+	}
+	if !rxRFC5321DotString.MatchString(local) && !rxRFC5321QuotedString.MatchString(local) {
+		return false
+	}
+	if strings.HasPrefix(domain, "[") && strings.HasSuffix(domain, "]") {
+		literal := domain[1 : len(domain)-1]
+		if strings.HasPrefix(literal, "IPv6:") {
+			return IsIPv6(strings.TrimPrefix(literal, "IPv6:"))
+		}
+		return IsIPv4(literal)
+	}
+	return rxRFC5321Domain.MatchString(domain)
+}
+
+// IsMultipleEmails check if the string is a list of emails separated by commas or semicolons,
+// e.g. "foo@bar.com, baz@qux.com". Whitespace around each email is ignored.
+func IsMultipleEmails(str string) bool {
+	for _, email := range strings.Split(strings.Replace(str, ";", ",", -1), ",") {
+		if !IsEmail(strings.TrimSpace(email)) {
+			return false
+		}
+	}
+	return true
+}
+
 // IsURL check if the string is an URL.
 func IsURL(str string) bool {
 	if str == "" || utf8.RuneCountInString(str) >= maxURLRuneCount || len(str) <= minURLRuneCount || strings.HasPrefix(str, ".") {
@@ -124,6 +216,125 @@ func IsURL(str string) bool {
 	return rxURL.MatchString(str)
 }
 
+// IsWSSURL check if the string is a WebSocket URL, i.e. a valid URL with the ws:// or wss:// scheme.
+func IsWSSURL(str string) bool {
+	u, err := url.Parse(str)
+	if err != nil || (u.Scheme != "ws" && u.Scheme != "wss") || u.Host == "" {
+		return false
+	}
+	return IsURL(str)
+}
+
+// IsFullURL check if the string is an absolute URL with both a scheme and a host, e.g. "https://example.com/path".
+// Unlike IsURL, bare hostnames such as "example.com" are not accepted.
+func IsFullURL(str string) bool {
+	u, err := url.Parse(str)
+	if err != nil {
+		return false
+	}
+	return u.Scheme != "" && u.Hostname() != ""
+}
+
+// IsGitHubURL check if the string is a GitHub repository URL, either https://github.com/owner/repo
+// (with an optional trailing slash) or git@github.com:owner/repo. Both forms allow a ".git" suffix.
+// Owner and repository names may contain letters, digits, hyphens, underscores and dots.
+func IsGitHubURL(str string) bool {
+	var path string
+	switch {
+	case strings.HasPrefix(str, "https://github.com/"):
+		path = strings.TrimSuffix(strings.TrimPrefix(str, "https://github.com/"), "/")
+	case strings.HasPrefix(str, "git@github.com:"):
+		path = strings.TrimPrefix(str, "git@github.com:")
+	default:
+		return false
+	}
+	segments := strings.Split(path, "/")
+	if len(segments) != 2 {
+		return false
+	}
+	// the optional .git suffix is not part of the repository name
+	segments[1] = strings.TrimSuffix(segments[1], ".git")
+	for _, segment := range segments {
+		if segment == "." || segment == ".." || !rxGitHubName.MatchString(segment) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsNeo4jConnectionURI check if the string is a Neo4j connection URI using one of the
+// bolt://, bolt+s://, bolt+ssc://, neo4j://, neo4j+s:// or neo4j+ssc:// schemes and a valid host.
+func IsNeo4jConnectionURI(str string) bool {
+	u, err := url.Parse(str)
+	if err != nil {
+		return false
+	}
+	switch u.Scheme {
+	case "bolt", "bolt+s", "bolt+ssc", "neo4j", "neo4j+s", "neo4j+ssc":
+	default:
+		return false
+	}
+	if port := u.Port(); port != "" && !IsPort(port) {
+		return false
+	}
+	return IsHost(u.Hostname())
+}
+
+// IsGitRemoteURL check if the string is a Git remote URL: http(s)://, ssh://, git:// or
+// the SCP-like SSH syntax [user@]host:path (e.g. git@github.com:user/repo.git).
+// In the SCP-like syntax a host without a dot (e.g. an SSH config alias) needs a user part,
+// and the path must not be a port number or contain backslashes.
+func IsGitRemoteURL(str string) bool {
+	if str == "" || HasWhitespace(str) {
+		return false
+	}
+	if strings.Contains(str, "://") {
+		u, err := url.Parse(str)
+		if err != nil {
+			return false
+		}
+		switch u.Scheme {
+		case "http", "https", "ssh", "git", "git+ssh", "ssh+git":
+		default:
+			return false
+		}
+		if port := u.Port(); port != "" && !IsPort(port) {
+			return false
+		}
+		return IsHost(u.Hostname()) && strings.Trim(u.Path, "/") != ""
+	}
+
+	// SCP-like syntax is only recognized when there is no slash before the first colon
+	colon := strings.Index(str, ":")
+	if colon < 0 || strings.Contains(str[:colon], "/") || colon == len(str)-1 {
+		return false
+	}
+	host, path := str[:colon], str[colon+1:]
+	if strings.Contains(path, "\\") || rxNumeric.MatchString(path) {
+		// a Windows path (C:\Users) or a host:port pair (localhost:3000)
+		return false
+	}
+	hasUser := false
+	if at := strings.LastIndex(host, "@"); at >= 0 {
+		if !rxGitSCPUser.MatchString(host[:at]) {
+			return false
+		}
+		host = host[at+1:]
+		hasUser = true
+	}
+	if len(host) < 2 || (!hasUser && !strings.Contains(host, ".")) {
+		// single letters are Windows drive letters, bare words are more likely "key:value" than a host
+		return false
+	}
+	return IsDNSName(host) || IsIPv4(host)
+}
+
+// IsOpenAPIPath check if the string is an OpenAPI path template, e.g. /users/{userId}/posts/{postId}:
+// it must start with "/", contain only URL path characters and path parameters whose names are identifiers.
+func IsOpenAPIPath(str string) bool {
+	return rxOpenAPIPath.MatchString(str)
+}
+
 // IsRequestURL check if the string rawurl, assuming
 // it was received in an HTTP request, is a valid
 // URL confirm to RFC 3986
@@ -248,6 +459,17 @@ func IsHexadecimal(str string) bool {
 	return rxHexadecimal.MatchString(str)
 }
 
+// IsHexadecimalWithPrefix check if the string is a hexadecimal number with a 0x or 0X prefix, e.g. "0x1A".
+// Unlike IsEthereumAddress, any number of hex digits (at least one) is accepted.
+func IsHexadecimalWithPrefix(str string) bool {
+	return rxHexadecimalWithPrefix.MatchString(str)
+}
+
+// IsBinaryDigits check if the string contains only binary digits (0 and 1), optionally with a 0b or 0B prefix.
+func IsBinaryDigits(str string) bool {
+	return rxBinaryDigits.MatchString(str)
+}
+
 // IsHexcolor check if the string is a hexadecimal color.
 func IsHexcolor(str string) bool {
 	return rxHexcolor.MatchString(str)
@@ -258,6 +480,24 @@ func IsRGBcolor(str string) bool {
 	return rxRGBcolor.MatchString(str)
 }
 
+// IsColor check if the string is a CSS color in any representation: #RGB, #RGBA, #RRGGBB, #RRGGBBAA,
+// rgb(...), rgba(...), hsl(...), hsla(...) or a named color such as "rebeccapurple".
+func IsColor(str string) bool {
+	if _, ok := cssNamedColors[strings.ToLower(str)]; ok {
+		return true
+	}
+	return rxCSSHexColor.MatchString(str) ||
+		IsRGBcolor(str) ||
+		rxRGBAcolor.MatchString(str) ||
+		rxHSLcolor.MatchString(str)
+}
+
+// IsPantoneColor check if the string is a Pantone color code in the Pantone Matching System
+// form "Pantone NNN C/U/M" or the Pantone Plus series form "Pantone P NNN-N C/U".
+func IsPantoneColor(str string) bool {
+	return rxPantonePMS.MatchString(str) || rxPantonePlus.MatchString(str)
+}
+
 // IsLowerCase check if the string is lowercase. Empty string is valid.
 func IsLowerCase(str string) bool {
 	if IsNull(str) {
@@ -274,6 +514,12 @@ func IsUpperCase(str string) bool {
 	return str == strings.ToUpper(str)
 }
 
+// IsPascalCase check if the string is in PascalCase: an uppercase letter followed by
+// letters and digits only, e.g. "MyField" or "HTTPSHandler".
+func IsPascalCase(str string) bool {
+	return rxPascalCase.MatchString(str)
+}
+
 // HasLowerCase check if the string contains at least 1 lowercase. Empty string is valid.
 func HasLowerCase(str string) bool {
 	if IsNull(str) {
@@ -331,11 +577,46 @@ func HasWhitespace(str string) bool {
     return len(str) > 0 && rxHasWhitespace.MatchString(str)
 }
 
+// IsSingleLine check if the string doesn't contain any line breaks (\n or \r). Empty string is valid.
+func IsSingleLine(str string) bool {
+	return !strings.ContainsAny(str, "\r\n")
+}
+
+// IsNoControlChars check if the string doesn't contain any ASCII control characters (0x00-0x1F and 0x7F),
+// including tab, newline and carriage return. Empty string is valid.
+func IsNoControlChars(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] < 0x20 || str[i] == 0x7F {
+			return false
+		}
+	}
+	return true
+}
+
+// IsNoDuplicateWords check if the whitespace separated words of the string are unique
+// (case-insensitive). Punctuation is considered part of a word. Empty string is valid.
+func IsNoDuplicateWords(str string) bool {
+	seen := make(map[string]struct{})
+	for _, word := range strings.Fields(str) {
+		word = strings.ToLower(word)
+		if _, ok := seen[word]; ok {
+			return false
+		}
+		seen[word] = struct{}{}
+	}
+	return true
+}
+
 // IsByteLength check if the string's length (in bytes) falls in a range.
 func IsByteLength(str string, min, max int) bool {
 	return len(str) >= min && len(str) <= max
 }
 
+// IsUUIDv1 check if the string is a UUID version 1.
+func IsUUIDv1(str string) bool {
+	return rxUUID1.MatchString(str)
+}
+
 // IsUUIDv3 check if the string is a UUID version 3.
 func IsUUIDv3(str string) bool {
 	return rxUUID3.MatchString(str)
@@ -351,7 +632,17 @@ func IsUUIDv5(str string) bool {
 	return rxUUID5.MatchString(str)
 }
 
-// IsUUID check if the string is a UUID (version 3, 4 or 5).
+// IsUUIDv6 check if the string is a UUID version 6.
+func IsUUIDv6(str string) bool {
+	return rxUUID6.MatchString(str)
+}
+
+// IsUUIDv7 check if the string is a UUID version 7.
+func IsUUIDv7(str string) bool {
+	return rxUUID7.MatchString(str)
+}
+
+// IsUUID check if the string is a UUID (version 1, 3, 4, 5, 6 or 7).
 func IsUUID(str string) bool {
 	return rxUUID.MatchString(str)
 }
@@ -385,6 +676,89 @@ func IsCreditCard(str string) bool {
 	return sum%10 == 0
 }
 
+// IsORCIDID check if the string is an ORCID researcher identifier (XXXX-XXXX-XXXX-XXXX) with a valid
+// check character, either bare or in the URL form https://orcid.org/XXXX-XXXX-XXXX-XXXX.
+func IsORCIDID(str string) bool {
+	if !rxORCID.MatchString(str) {
+		return false
+	}
+	return isISO7064Mod112(strings.Replace(strings.TrimPrefix(str, "https://orcid.org/"), "-", "", -1))
+}
+
+// IsISNI check if the string is an International Standard Name Identifier with a valid check character,
+// either compact (16 characters) or spaced (XXXX XXXX XXXX XXXX).
+func IsISNI(str string) bool {
+	return rxISNI.MatchString(str) && isISO7064Mod112(strings.Replace(str, " ", "", -1))
+}
+
+// IsARXIV check if the string is an arXiv identifier, either in the current YYMM.NNNNN form
+// (e.g. 1705.01234 or 2305.01234v2, issued from April 2007 up to the current month) or in the legacy
+// archive/YYMMNNN form (e.g. math/0304151).
+func IsARXIV(str string) bool {
+	if rxArXivLegacyID.MatchString(str) {
+		return true
+	}
+	m := rxArXivID.FindStringSubmatch(str)
+	if m == nil {
+		return false
+	}
+	year, _ := strconv.Atoi(m[1])
+	month, _ := strconv.Atoi(m[2])
+	if month < 1 || month > 12 || year < 7 || (year == 7 && month < 4) {
+		return false
+	}
+	now := time.Now().UTC()
+	if 2000+year > now.Year() || (2000+year == now.Year() && month > int(now.Month())) {
+		return false
+	}
+	// the sequence number has 4 digits until 2014 and 5 digits since 2015
+	return (year < 15) == (len(m[3]) == 4)
+}
+
+// IsPMID check if the string is a PubMed identifier: a positive number of at most 8 digits without leading zeros.
+func IsPMID(str string) bool {
+	return len(str) <= 8 && rxNumeric.MatchString(str) && str[0] != '0'
+}
+
+// isISO7064Mod112 validates the ISO 7064 MOD 11-2 check character (the last character, a digit or X)
+// of a numeric string.
+func isISO7064Mod112(str string) bool {
+	if len(str) < 2 {
+		return false
+	}
+	sum := 0
+	for _, c := range str[:len(str)-1] {
+		if c < '0' || c > '9' {
+			return false
+		}
+		sum = (sum + int(c-'0')) * 2
+	}
+	check := (12 - sum%11) % 11
+	if check == 10 {
+		return str[len(str)-1] == 'X'
+	}
+	return int(str[len(str)-1]-'0') == check
+}
+
+// IsVIN check if the string is a 17 character Vehicle Identification Number (letters I, O and Q are not
+// allowed) with a valid check digit at position 9, as defined by NHTSA.
+func IsVIN(str string) bool {
+	if !rxVIN.MatchString(str) {
+		return false
+	}
+	const values = "0123456789.ABCDEFGH..JKLMN.P.R..STUVWXYZ"
+	weights := [17]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+	sum := 0
+	for i := 0; i < len(str); i++ {
+		sum += (strings.IndexByte(values, str[i]) % 10) * weights[i]
+	}
+	check := byte('0' + sum%11)
+	if sum%11 == 10 {
+		check = 'X'
+	}
+	return str[8] == check
+}
+
 // IsISBN10 check if the string is an ISBN version 10.
 func IsISBN10(str string) bool {
 	return IsISBN(str, 10)
@@ -430,12 +804,163 @@ func IsISBN(str string, version int) bool {
 	return IsISBN(str, 10) || IsISBN(str, 13)
 }
 
+// IsUPCBarcode check if the string is a 12 digit UPC-A barcode with a valid check digit.
+func IsUPCBarcode(str string) bool {
+	return len(str) == 12 && isGS1Checksum(str)
+}
+
+// IsGTIN check if the string is a Global Trade Item Number (GTIN-8, GTIN-12, GTIN-13 or GTIN-14)
+// with a valid check digit. This covers EAN-8, UPC-A and EAN-13 barcodes.
+func IsGTIN(str string) bool {
+	switch len(str) {
+	case 8, 12, 13, 14:
+		return isGS1Checksum(str)
+	}
+	return false
+}
+
+// isGS1Checksum validates the GS1 check digit (the last digit) of a numeric string.
+// Digits are weighted alternately by 3 and 1, starting with 3 next to the check digit.
+func isGS1Checksum(str string) bool {
+	if len(str) < 2 || !rxNumeric.MatchString(str) {
+		return false
+	}
+	sum := 0
+	for i := len(str) - 2; i >= 0; i-- {
+		digit := int(str[i] - '0')
+		if (len(str)-i)%2 == 0 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return (10-sum%10)%10 == int(str[len(str)-1]-'0')
+}
+
+// isISBNAny is the single argument form of IsISBN used by the "isbn" tag,
+// accepting both ISBN-10 and ISBN-13.
+func isISBNAny(str string) bool {
+	return IsISBN(str, 0)
+}
+
 // IsJSON check if the string is valid JSON (note: uses json.Unmarshal).
 func IsJSON(str string) bool {
 	var js json.RawMessage
 	return json.Unmarshal([]byte(str), &js) == nil
 }
 
+// IsJSON5 check if the string is valid JSON5, i.e. JSON extended with comments,
+// trailing commas, unquoted keys and the other JSON5 syntax additions.
+func IsJSON5(str string) bool {
+	var v interface{}
+	return json5.Unmarshal([]byte(str), &v) == nil
+}
+
+// IsNDJSON check if the string is newline-delimited JSON, i.e. every non-empty line is valid JSON.
+// A string without any JSON line is not valid.
+func IsNDJSON(str string) bool {
+	found := false
+	for _, line := range strings.Split(str, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if line == "" {
+			continue
+		}
+		if !IsJSON(line) {
+			return false
+		}
+		found = true
+	}
+	return found
+}
+
+// IsJSONMergePatch check if the string is a JSON Merge Patch document (RFC 7396),
+// i.e. valid JSON whose top-level value is an object.
+func IsJSONMergePatch(str string) bool {
+	var patch map[string]json.RawMessage
+	return json.Unmarshal([]byte(str), &patch) == nil && patch != nil
+}
+
+// IsJSONSchema check if the string is a plausible JSON Schema document: a JSON object with a string
+// "$schema" property or a "type" property (a type name or an array of type names) at the top level.
+// The document is not validated against the JSON Schema meta-schema.
+func IsJSONSchema(str string) bool {
+	var schema map[string]json.RawMessage
+	if json.Unmarshal([]byte(str), &schema) != nil || schema == nil {
+		return false
+	}
+	if raw, ok := schema["$schema"]; ok {
+		var uri string
+		if json.Unmarshal(raw, &uri) == nil {
+			return true
+		}
+	}
+	if raw, ok := schema["type"]; ok {
+		var name string
+		var names []string
+		return json.Unmarshal(raw, &name) == nil || json.Unmarshal(raw, &names) == nil
+	}
+	return false
+}
+
+// IsJMESPath check if the string is a valid JMESPath query expression.
+func IsJMESPath(str string) bool {
+	_, err := jmespath.Compile(str)
+	return err == nil
+}
+
+// IsXPathExpression check if the string is a valid XPath expression.
+func IsXPathExpression(str string) bool {
+	if str == "" {
+		return false
+	}
+	_, err := xpath.Compile(str)
+	return err == nil
+}
+
+// IsCSSSelector check if the string is a valid CSS selector (or comma separated group of selectors).
+func IsCSSSelector(str string) bool {
+	_, err := cascadia.Compile(str)
+	return err == nil
+}
+
+// IsCSSUnit check if the string is a CSS dimension: a number followed by an absolute (px, pt, pc, in, cm, mm),
+// relative (em, rem, ex, ch), viewport (vh, vw, vmin, vmax) or flex (fr) unit, or a percentage.
+func IsCSSUnit(str string) bool {
+	return rxCSSUnit.MatchString(str)
+}
+
+// IsCSSVariableName check if the string is a CSS custom property name: "--" followed by at least one
+// letter, digit, hyphen, underscore or non-ASCII character, e.g. "--primary-color".
+func IsCSSVariableName(str string) bool {
+	return rxCSSVariableName.MatchString(str)
+}
+
+// IsValidUTF8 check if the string consists entirely of valid UTF-8 encoded runes. Empty string is valid.
+func IsValidUTF8(str string) bool {
+	return utf8.ValidString(str)
+}
+
+// IsBOMFree check if the string doesn't start with the UTF-8 byte order mark (\xEF\xBB\xBF). Empty string is valid.
+func IsBOMFree(str string) bool {
+	return !strings.HasPrefix(str, "\xEF\xBB\xBF")
+}
+
+// IsNoEmoji check if the string doesn't contain any emoji, i.e. characters from the emoji Unicode blocks
+// such as Emoticons or Miscellaneous Symbols and Pictographs. Empty string is valid.
+func IsNoEmoji(str string) bool {
+	for _, c := range str {
+		if unicode.Is(emojiBlocks, c) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsHTMLSafe check if the string doesn't contain any of the characters < > & " ' ` which could be used for
+// cross-site scripting when the string is inserted into HTML without escaping. Empty string is valid.
+func IsHTMLSafe(str string) bool {
+	return !strings.ContainsAny(str, "<>&\"'`")
+}
+
 // IsMultibyte check if the string contains one or more multibyte chars. Empty string is valid.
 func IsMultibyte(str string) bool {
 	if IsNull(str) {
@@ -513,6 +1038,16 @@ func IsDataURI(str string) bool {
 	return IsBase64(dataURI[1])
 }
 
+// IsBase64Image checks if a string is a base64 encoded image data URI of type
+// png, jpeg, gif, webp or svg+xml. The image content itself is not decoded.
+func IsBase64Image(str string) bool {
+	loc := rxBase64Image.FindStringIndex(str)
+	if loc == nil {
+		return false
+	}
+	return IsBase64(str[loc[1]:])
+}
+
 // IsISO3166Alpha2 checks if a string is valid two-letter country code
 func IsISO3166Alpha2(str string) bool {
 	for _, entry := range ISO3166List {
@@ -533,6 +1068,22 @@ func IsISO3166Alpha3(str string) bool {
 	return false
 }
 
+// IsISO3166Numeric checks if a string is valid three-digit numeric country code
+func IsISO3166Numeric(str string) bool {
+	for _, entry := range ISO3166List {
+		if str == entry.Numeric {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCountryCode checks if a string is valid country code in any ISO 3166-1 form: two-letter (US),
+// three-letter (USA) or numeric (840)
+func IsCountryCode(str string) bool {
+	return IsISO3166Alpha2(str) || IsISO3166Alpha3(str) || IsISO3166Numeric(str)
+}
+
 // IsISO693Alpha2 checks if a string is valid two-letter language code
 func IsISO693Alpha2(str string) bool {
 	for _, entry := range ISO693List {
@@ -553,6 +1104,13 @@ func IsISO693Alpha3b(str string) bool {
 	return false
 }
 
+// IsLanguageCode checks if a string is valid two-letter (en) or three-letter (eng) ISO 639 language code,
+// ignoring case
+func IsLanguageCode(str string) bool {
+	str = strings.ToLower(str)
+	return IsISO693Alpha2(str) || IsISO693Alpha3b(str)
+}
+
 // IsDNSName will validate the given string as a DNS name
 func IsDNSName(str string) bool {
 	if str == "" || len(strings.Replace(str, ".", "", -1)) > 255 {
@@ -562,6 +1120,38 @@ func IsDNSName(str string) bool {
 	return !IsIP(str) && rxDNSName.MatchString(str)
 }
 
+// IsSubdomain check if the string is a single DNS label, e.g. the "api" in "api.example.com":
+// 1 to 63 letters, digits and hyphens, not starting or ending with a hyphen.
+// Use IsDNSName to validate a full hostname.
+func IsSubdomain(str string) bool {
+	return rxDNSLabel.MatchString(str)
+}
+
+// IsTLDValid check if the string is an IANA-registered top-level domain, e.g. "com", "de" or "xn--p1ai".
+// The check is case-insensitive and a single leading dot is allowed (".com").
+func IsTLDValid(str string) bool {
+	tld := strings.ToLower(strings.TrimPrefix(str, "."))
+	tldListMutex.RLock()
+	defer tldListMutex.RUnlock()
+	_, ok := tldList[tld]
+	return ok
+}
+
+// UpdateTLDList replaces the list of top-level domains used by IsTLDValid, e.g. with the lines of
+// https://data.iana.org/TLD/tlds-alpha-by-domain.txt. Empty entries and "#" comments are ignored.
+func UpdateTLDList(tlds []string) {
+	list := make(map[string]struct{}, len(tlds))
+	for _, tld := range tlds {
+		tld = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tld), "."))
+		if tld != "" && !strings.HasPrefix(tld, "#") {
+			list[tld] = struct{}{}
+		}
+	}
+	tldListMutex.Lock()
+	defer tldListMutex.Unlock()
+	tldList = list
+}
+
 // IsHash checks if a string is a hash of type algorithm.
 // Algorithm is one of ['md4', 'md5', 'sha1', 'sha256', 'sha384', 'sha512', 'ripemd128', 'ripemd160', 'tiger128', 'tiger160', 'tiger192', 'crc32', 'crc32b']
 func IsHash(str string, algorithm string) bool {
@@ -612,6 +1202,12 @@ func IsPort(str string) bool {
 	return false
 }
 
+// IsHostnamePort checks if a string is a host:port pair, e.g. localhost:3000 or [::1]:8080
+func IsHostnamePort(str string) bool {
+	host, port, err := net.SplitHostPort(str)
+	return err == nil && IsHost(host) && IsPort(port)
+}
+
 // IsIPv4 check if the string is an IP version 4.
 func IsIPv4(str string) bool {
 	ip := net.ParseIP(str)
@@ -624,6 +1220,18 @@ func IsIPv6(str string) bool {
 	return ip != nil && strings.Contains(str, ":")
 }
 
+// IsMulticastIP check if the string is an IPv4 (224.0.0.0/4) or IPv6 (ff00::/8) multicast address.
+func IsMulticastIP(str string) bool {
+	ip := net.ParseIP(str)
+	return ip != nil && ip.IsMulticast()
+}
+
+// IsIPv4MappedIPv6 check if the string is an IPv4-mapped IPv6 address, e.g. ::ffff:192.168.1.1.
+func IsIPv4MappedIPv6(str string) bool {
+	ip := net.ParseIP(str)
+	return ip != nil && ip.To4() != nil && strings.Contains(str, ":")
+}
+
 // IsCIDR check if the string is an valid CIDR notiation (IPV4 & IPV6)
 func IsCIDR(str string) bool {
 	_, _, err := net.ParseCIDR(str)
@@ -697,17 +1305,57 @@ func IsRsaPublicKey(str string, keylen int) bool {
 	return bitlen == int(keylen)
 }
 
-func toJSONName(tag string) string {
-	if tag == "" {
-		return ""
+// IsECDSAPublicKey check if a string is a PEM encoded ECDSA public key on the P-256, P-384 or P-521 curve
+func IsECDSAPublicKey(str string) bool {
+	block, _ := pem.Decode([]byte(str))
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return false
 	}
-
-	// JSON name always comes first. If there's no options then split[0] is
-	// JSON name, if JSON name is not set, then split[0] is an empty string.
-	split := strings.SplitN(tag, ",", 2)
-
-	name := split[0]
-
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return false
+	}
+	pubkey, ok := key.(*ecdsa.PublicKey)
+	if !ok {
+		return false
+	}
+	switch pubkey.Curve {
+	case elliptic.P256(), elliptic.P384(), elliptic.P521():
+		return true
+	}
+	return false
+}
+
+// IsED25519PublicKey check if a string is an Ed25519 public key, either as the base64
+// encoding of the raw 32 byte key or as a PEM encoded PKIX public key
+func IsED25519PublicKey(str string) bool {
+	if len(str) == 44 {
+		raw, err := base64.StdEncoding.DecodeString(str)
+		return err == nil && len(raw) == ed25519.PublicKeySize
+	}
+	block, _ := pem.Decode([]byte(str))
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return false
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return false
+	}
+	_, ok := key.(ed25519.PublicKey)
+	return ok
+}
+
+func toJSONName(tag string) string {
+	if tag == "" {
+		return ""
+	}
+
+	// JSON name always comes first. If there's no options then split[0] is
+	// JSON name, if JSON name is not set, then split[0] is an empty string.
+	split := strings.SplitN(tag, ",", 2)
+
+	name := split[0]
+
 	// However it is possible that the field is skipped when
 	// (de-)serializing from/to JSON, in which case assume that there is no
 	// tag name to use
@@ -733,6 +1381,18 @@ func PrependPathToErrors(err error, path string) error {
 	return err
 }
 
+// RegisterStructValidationFn registers fn to be called by ValidateStruct for every struct of type t
+// (or the type t points to), after the field-level validation. Use it for constraints spanning multiple
+// fields, e.g. "start date must be before end date". An error returned by fn fails the validation and
+// is reported as an Error with an empty Name; ErrorsByField lists it under the path of the struct within
+// the validated value ("" for the top-level struct). Passing a nil fn removes the registration.
+func RegisterStructValidationFn(t reflect.Type, fn StructValidationFn) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	structValidationFns.Set(t, fn)
+}
+
 // ValidateStruct use tags for fields.
 // result will be equal to `false` if there are any errors.
 func ValidateStruct(s interface{}) (bool, error) {
@@ -797,6 +1457,12 @@ func ValidateStruct(s interface{}) (bool, error) {
 		}
 		result = result && resultField && structResult
 	}
+	if fn, ok := structValidationFns.Get(val.Type()); ok && fn != nil {
+		if err2 := fn(val.Interface()); err2 != nil {
+			errs = append(errs, Error{Name: "", Err: err2})
+			result = false
+		}
+	}
 	if len(errs) > 0 {
 		err = errs
 	}
@@ -843,6 +1509,18 @@ func isValidTag(s string) bool {
 	return true
 }
 
+// IsRippleAddress check if the string is a Ripple (XRP) classic address: a leading "r"
+// followed by base58 characters, 25 to 34 characters in total.
+func IsRippleAddress(str string) bool {
+	return rxRippleAddress.MatchString(str)
+}
+
+// IsStellarAddress check if the string is a Stellar public key: a leading "G"
+// followed by base32 characters, 56 characters in total.
+func IsStellarAddress(str string) bool {
+	return rxStellarAddress.MatchString(str)
+}
+
 // IsSSN will validate the given string as a U.S. Social Security Number
 func IsSSN(str string) bool {
 	if str == "" || len(str) != 11 {
@@ -851,6 +1529,81 @@ func IsSSN(str string) bool {
 	return rxSSN.MatchString(str)
 }
 
+// IsEIN will validate the given string as a U.S. Employer Identification Number,
+// either formatted (XX-XXXXXXX) or as 9 plain digits
+func IsEIN(str string) bool {
+	if !rxEIN.MatchString(str) {
+		return false
+	}
+	switch str[:2] {
+	case "00", "07", "08", "09", "17", "18", "19", "28", "29", "49", "69", "70", "78", "79", "89":
+		return false
+	}
+	return true
+}
+
+// IsSIN will validate the given string as a Canadian Social Insurance Number,
+// either spaced (XXX XXX XXX) or as 9 plain digits
+func IsSIN(str string) bool {
+	if !rxSIN.MatchString(str) {
+		return false
+	}
+	return isLuhnValid(strings.Replace(str, " ", "", -1))
+}
+
+// IsNPI will validate the given string as a U.S. National Provider Identifier: 10 digits starting with 1 or 2,
+// with the check digit computed by the Luhn algorithm over the number prefixed with 80840
+func IsNPI(str string) bool {
+	return rxNPI.MatchString(str) && isLuhnValid("80840"+str)
+}
+
+// IsIMEI will validate the given string as a 15 digit International Mobile Equipment Identity with a valid
+// Luhn check digit. Spaces and hyphens are ignored
+func IsIMEI(str string) bool {
+	sanitized := whiteSpacesAndMinus.ReplaceAllString(str, "")
+	return len(sanitized) == 15 && rxNumeric.MatchString(sanitized) && isLuhnValid(sanitized)
+}
+
+// IsIMEISV will validate the given string as a 16 digit IMEI Software Version, which has no check digit.
+// Spaces and hyphens are ignored
+func IsIMEISV(str string) bool {
+	sanitized := whiteSpacesAndMinus.ReplaceAllString(str, "")
+	return len(sanitized) == 16 && rxNumeric.MatchString(sanitized)
+}
+
+// IsBankRoutingNumber will validate the given string as a U.S. ABA routing transit number, either as
+// 9 plain digits or formatted (XXX-XXX-XXX), with a valid checksum
+func IsBankRoutingNumber(str string) bool {
+	if !rxABARoutingNumber.MatchString(str) {
+		return false
+	}
+	digits := strings.Replace(str, "-", "", -1)
+	weights := [3]int{3, 7, 1}
+	sum := 0
+	for i := 0; i < len(digits); i++ {
+		sum += int(digits[i]-'0') * weights[i%3]
+	}
+	return sum%10 == 0
+}
+
+// isLuhnValid reports whether the string of ASCII digits passes the Luhn checksum.
+func isLuhnValid(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
 // IsSemver check if string is valid semantic version
 func IsSemver(str string) bool {
 	return rxSemver.MatchString(str)
@@ -872,6 +1625,95 @@ func IsRFC3339WithoutZone(str string) bool {
 	return IsTime(str, RF3339WithoutZone)
 }
 
+// IsDateOfBirth check if string is a plausible date of birth: an RFC3339 date (2006-01-02) or timestamp
+// which is not in the future and not more than MaxDateOfBirthAge years in the past.
+func IsDateOfBirth(str string) bool {
+	age, ok := ageFromBirthDate(str)
+	return ok && age >= MinDateOfBirthAge && age <= MaxDateOfBirthAge
+}
+
+// IsWorkingDay check if string is an RFC3339 date (2006-01-02) or timestamp falling on a working day,
+// i.e. Monday to Friday. Public holidays are not taken into account.
+func IsWorkingDay(str string) bool {
+	date, ok := parseDate(str)
+	return ok && date.Weekday() != time.Saturday && date.Weekday() != time.Sunday
+}
+
+// IsWeekend check if string is an RFC3339 date (2006-01-02) or timestamp falling on a Saturday or Sunday.
+func IsWeekend(str string) bool {
+	date, ok := parseDate(str)
+	return ok && (date.Weekday() == time.Saturday || date.Weekday() == time.Sunday)
+}
+
+// IsISO8601Week check if string is an ISO 8601 week date in the form YYYY-Www (e.g. 2024-W42) or YYYY-Www-D
+// (e.g. 2024-W42-3), with a year from 0001 to 9999. Week 53 is only valid in years which have 53 ISO weeks.
+func IsISO8601Week(str string) bool {
+	m := rxISO8601Week.FindStringSubmatch(str)
+	if m == nil {
+		return false
+	}
+	year, _ := strconv.Atoi(m[1])
+	if year < 1 {
+		return false
+	}
+	if m[2] == "53" {
+		// December 28th is always in the last week of the ISO year
+		if _, weeks := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek(); weeks != 53 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsMonth check if string is a month: a number from 1 to 12 (with or without a leading zero) or an English
+// month name, full or abbreviated to 3 letters (case-insensitive).
+func IsMonth(str string) bool {
+	if rxMonth.MatchString(str) {
+		return true
+	}
+	for m := time.January; m <= time.December; m++ {
+		if strings.EqualFold(str, m.String()) || strings.EqualFold(str, m.String()[:3]) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsQuarter check if string is a quarter of the year: 1 to 4, optionally prefixed with "Q" (case-insensitive).
+func IsQuarter(str string) bool {
+	return rxQuarter.MatchString(str)
+}
+
+// parseDate parses an RFC3339 full-date (2006-01-02) or timestamp.
+func parseDate(str string) (time.Time, bool) {
+	for _, layout := range []string{"2006-01-02", time.RFC3339} {
+		if t, err := time.Parse(layout, str); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// ageFromBirthDate parses the RFC3339 birth date and returns today's age in full years.
+// ok is false if the date can't be parsed or is in the future.
+func ageFromBirthDate(str string) (age int, ok bool) {
+	birth, ok := parseDate(str)
+	now := time.Now().UTC()
+	if !ok || birth.After(now) {
+		return 0, false
+	}
+	return ageInYears(birth, now), true
+}
+
+// ageInYears returns the number of full years passed between birth and now.
+func ageInYears(birth, now time.Time) int {
+	age := now.Year() - birth.Year()
+	if now.Month() < birth.Month() || (now.Month() == birth.Month() && now.Day() < birth.Day()) {
+		age--
+	}
+	return age
+}
+
 // IsISO4217 check if string is valid ISO currency code
 func IsISO4217(str string) bool {
 	for _, currency := range ISO4217List {
@@ -883,6 +1725,362 @@ func IsISO4217(str string) bool {
 	return false
 }
 
+// IsElasticsearchIndexName check if the string is a valid Elasticsearch index name.
+// Index names must be lowercase, can't start with '_', '-' or '+', can't contain
+// any of \ / * ? " < > | , # or space and are limited to 255 bytes.
+func IsElasticsearchIndexName(str string) bool {
+	if str == "" || len(str) > 255 || str == "." || str == ".." {
+		return false
+	}
+	if strings.ContainsAny(str[:1], "_-+") || strings.ContainsAny(str, "\\/*?\"<>| ,#") {
+		return false
+	}
+	return str == strings.ToLower(str)
+}
+
+// IsGoIdentifier check if the string is a valid Go identifier: a letter or underscore
+// followed by letters, digits or underscores. Go keywords are not valid identifiers.
+func IsGoIdentifier(str string) bool {
+	if str == "" || token.Lookup(str).IsKeyword() {
+		return false
+	}
+	for i, c := range str {
+		if !unicode.IsLetter(c) && c != '_' && (i == 0 || !unicode.IsDigit(c)) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsGoExportedIdentifier check if the string is a valid exported Go identifier,
+// i.e. a Go identifier starting with an uppercase letter.
+func IsGoExportedIdentifier(str string) bool {
+	if !IsGoIdentifier(str) {
+		return false
+	}
+	first, _ := utf8.DecodeRuneInString(str)
+	return unicode.IsUpper(first)
+}
+
+// IsPostgresIdentifier check if the string is a valid unquoted PostgreSQL identifier: a letter or underscore
+// followed by letters, digits, underscores or dollar signs, at most 63 bytes long (not characters).
+func IsPostgresIdentifier(str string) bool {
+	if str == "" || len(str) > 63 {
+		return false
+	}
+	for i, c := range str {
+		if !unicode.IsLetter(c) && c != '_' && (i == 0 || (!unicode.IsDigit(c) && c != '$')) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsEnvironmentVariableName check if the string is a portable POSIX environment variable name:
+// uppercase letters, digits and underscores, not starting with a digit.
+func IsEnvironmentVariableName(str string) bool {
+	return rxEnvVarName.MatchString(str)
+}
+
+// IsHTMLTagName check if the string is a standard HTML5 element name (case-insensitive)
+// or a valid custom element name, which must be lowercase and contain a hyphen.
+func IsHTMLTagName(str string) bool {
+	if _, ok := HTMLTagList[strings.ToLower(str)]; ok {
+		return true
+	}
+	switch str {
+	case "annotation-xml", "color-profile", "font-face", "font-face-src",
+		"font-face-uri", "font-face-format", "font-face-name", "missing-glyph":
+		// reserved by SVG and MathML, not valid custom element names
+		return false
+	}
+	return strings.Contains(str, "-") && rxCustomElementName.MatchString(str)
+}
+
+// IsHTMLAttributeName check if the string is a valid HTML attribute name, e.g. "class" or "data-user-id":
+// printable ASCII characters except space, quotes, ">", "/" and "=".
+func IsHTMLAttributeName(str string) bool {
+	return rxHTMLAttributeName.MatchString(str)
+}
+
+// IsXMLNCName check if the string is a valid XML non-colonized name (NCName), as used for
+// element and attribute names in namespace-aware documents. Colons are not allowed.
+func IsXMLNCName(str string) bool {
+	if str == "" {
+		return false
+	}
+	for i, c := range str {
+		if unicode.IsLetter(c) || c == '_' {
+			continue
+		}
+		if i == 0 {
+			return false
+		}
+		if !unicode.IsDigit(c) && !unicode.In(c, unicode.Mn, unicode.Mc) && !strings.ContainsRune("-.·", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSVGString check if the string is a well-formed XML document whose single root element is svg
+// in the SVG namespace (http://www.w3.org/2000/svg). The SVG content itself is not validated.
+func IsSVGString(str string) bool {
+	decoder := xml.NewDecoder(strings.NewReader(str))
+	depth, roots := 0, 0
+	for {
+		tok, err := decoder.Token()
+		if err == io.EOF {
+			return roots == 1 && depth == 0
+		}
+		if err != nil {
+			return false
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if depth == 0 {
+				roots++
+				if roots > 1 || t.Name.Local != "svg" || t.Name.Space != "http://www.w3.org/2000/svg" {
+					return false
+				}
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		case xml.CharData:
+			if depth == 0 && len(bytes.TrimSpace(t)) > 0 {
+				return false
+			}
+		}
+	}
+}
+
+// IsLDAPDN check if the string is an LDAP distinguished name as defined by RFC 4514,
+// e.g. "CN=John Doe,OU=Users,DC=example,DC=com". Attribute types may be given as
+// short names (CN, OU, DC, ...) or numeric OIDs (2.5.4.3).
+func IsLDAPDN(str string) bool {
+	if str == "" {
+		return false
+	}
+	for i := 0; ; i++ {
+		eq := strings.IndexByte(str[i:], '=')
+		if eq < 0 || !rxLDAPAttrType.MatchString(strings.TrimSpace(str[i:i+eq])) {
+			return false
+		}
+		i += eq + 1
+		n, ok := ldapValueLength(str[i:])
+		if !ok {
+			return false
+		}
+		i += n
+		if i == len(str) {
+			return true
+		}
+		// str[i] is an unescaped ',' or '+' separating the next attribute
+	}
+}
+
+// ldapValueLength returns the length of the RFC 4514 attribute value at the beginning
+// of s (ending before an unescaped ',' or '+') and whether the value is well formed.
+func ldapValueLength(s string) (int, bool) {
+	if strings.HasPrefix(s, "#") {
+		n := 1
+		for n+1 < len(s) && IsHexadecimal(s[n:n+2]) {
+			n += 2
+		}
+		return n, n > 1 && (n == len(s) || s[n] == ',' || s[n] == '+')
+	}
+	i := 0
+	for ; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == ',' || c == '+':
+			return i, i == 0 || s[i-1] != ' ' || isLDAPEscaped(s, i-1)
+		case c == '\\':
+			if i+1 < len(s) && strings.IndexByte("\"+,;<>\\ #=", s[i+1]) >= 0 {
+				i++
+			} else if i+2 < len(s) && IsHexadecimal(s[i+1:i+3]) {
+				i += 2
+			} else {
+				return 0, false
+			}
+		case c == '"' || c == ';' || c == '<' || c == '>' || c == 0:
+			return 0, false
+		case i == 0 && (c == ' ' || c == '#'):
+			return 0, false
+		}
+	}
+	return i, i == 0 || s[i-1] != ' ' || isLDAPEscaped(s, i-1)
+}
+
+// isLDAPEscaped reports whether the byte at position i of s is preceded by an odd
+// number of backslashes.
+func isLDAPEscaped(s string, i int) bool {
+	n := 0
+	for j := i - 1; j >= 0 && s[j] == '\\'; j-- {
+		n++
+	}
+	return n%2 == 1
+}
+
+// IsNFCNormalized check if the string is in Unicode Normalization Form C (canonical composition).
+func IsNFCNormalized(str string) bool {
+	return norm.NFC.IsNormalString(str)
+}
+
+// IsNFDNormalized check if the string is in Unicode Normalization Form D (canonical decomposition).
+func IsNFDNormalized(str string) bool {
+	return norm.NFD.IsNormalString(str)
+}
+
+// IsNFKCNormalized check if the string is in Unicode Normalization Form KC (compatibility composition).
+func IsNFKCNormalized(str string) bool {
+	return norm.NFKC.IsNormalString(str)
+}
+
+// IsNFKDNormalized check if the string is in Unicode Normalization Form KD (compatibility decomposition).
+func IsNFKDNormalized(str string) bool {
+	return norm.NFKD.IsNormalString(str)
+}
+
+// IsGRPCMethodPath check if the string is a gRPC method path such as "/package.ServiceName/MethodName".
+func IsGRPCMethodPath(str string) bool {
+	return rxGRPCMethodPath.MatchString(str)
+}
+
+// IsRabbitMQRoutingKey check if the string is a RabbitMQ topic routing key: dot separated
+// words of letters, digits, '-' and '_', where the wildcards '*' and '#' may only appear
+// as whole words. The key is limited to 255 bytes.
+func IsRabbitMQRoutingKey(str string) bool {
+	if str == "" || len(str) > 255 {
+		return false
+	}
+	for _, word := range strings.Split(str, ".") {
+		if !rxRabbitMQWord.MatchString(word) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsJWTAlgorithm check if the string is a JOSE algorithm identifier listed in ValidJWTAlgorithms.
+// Algorithm names are case-sensitive.
+func IsJWTAlgorithm(str string) bool {
+	_, ok := ValidJWTAlgorithms[str]
+	return ok
+}
+
+// IsSSHKeyFingerprint check if the string is an SSH public key fingerprint, either in
+// MD5 (MD5:xx:xx:...:xx) or SHA256 (SHA256:base64) format as printed by ssh-keygen.
+func IsSSHKeyFingerprint(str string) bool {
+	return rxSSHFingerprint.MatchString(str)
+}
+
+// IsNPMPackageName check if the string is a valid npm package name: 1 to 214 lowercase
+// letters, digits, hyphens, underscores and dots, not starting with a dot or underscore.
+// Scoped names (@scope/name) are valid if both the scope and the name conform.
+func IsNPMPackageName(str string) bool {
+	if str == "" || len(str) > 214 {
+		return false
+	}
+	if strings.HasPrefix(str, "@") {
+		parts := strings.Split(str[1:], "/")
+		return len(parts) == 2 && rxNPMNamePart.MatchString(parts[0]) && rxNPMNamePart.MatchString(parts[1])
+	}
+	return rxNPMNamePart.MatchString(str)
+}
+
+// IsAzureResourceID check if the string is an Azure resource ID, either at resource group level
+// (/subscriptions/{id}/resourceGroups/{name}) or a complete resource ID
+// (/subscriptions/{id}/resourceGroups/{name}/providers/{namespace}/{type}/{name}...).
+// The subscription ID must be a version 4 UUID.
+func IsAzureResourceID(str string) bool {
+	segments := strings.Split(str, "/")
+	if len(segments) < 5 || segments[0] != "" ||
+		!strings.EqualFold(segments[1], "subscriptions") || !IsUUIDv4(strings.ToLower(segments[2])) ||
+		!strings.EqualFold(segments[3], "resourceGroups") || !rxAzureRGName.MatchString(segments[4]) {
+		return false
+	}
+	if len(segments) == 5 {
+		return true
+	}
+	// providers/{namespace} followed by one or more {type}/{name} pairs
+	rest := segments[5:]
+	if len(rest) < 4 || len(rest)%2 != 0 ||
+		!strings.EqualFold(rest[0], "providers") || !rxAzureNamespace.MatchString(rest[1]) {
+		return false
+	}
+	for _, segment := range rest[2:] {
+		if segment == "" || HasWhitespaceOnly(segment) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsS3ObjectKey check if the string is a valid Amazon S3 object key: UTF-8 encoded,
+// 1 to 1024 bytes long and without null bytes.
+func IsS3ObjectKey(str string) bool {
+	return str != "" && len(str) <= 1024 && utf8.ValidString(str) && !strings.ContainsRune(str, 0)
+}
+
+// IsSafeS3ObjectKey check if the string is a valid S3 object key that only contains characters
+// safe to use in signed URLs without encoding: letters, digits, hyphens, underscores, dots and slashes.
+func IsSafeS3ObjectKey(str string) bool {
+	return IsS3ObjectKey(str) && rxSafeS3ObjectKey.MatchString(str)
+}
+
+// IsGCSObjectPath check if the string is a valid Google Cloud Storage object name: UTF-8 encoded,
+// 1 to 1024 bytes long, without carriage returns or line feeds, not "." or ".." and not starting
+// with the reserved ".well-known/acme-challenge/" prefix.
+func IsGCSObjectPath(str string) bool {
+	if str == "" || len(str) > 1024 || !utf8.ValidString(str) {
+		return false
+	}
+	if str == "." || str == ".." || strings.ContainsAny(str, "\r\n") {
+		return false
+	}
+	return !strings.HasPrefix(str, ".well-known/acme-challenge/")
+}
+
+// IsSafeGCSObjectPath check if the string is a valid GCS object name that only contains URL-safe
+// characters: letters, digits, hyphens, underscores, dots, tildes and slashes.
+func IsSafeGCSObjectPath(str string) bool {
+	return IsGCSObjectPath(str) && rxSafeGCSObjectPath.MatchString(str)
+}
+
+// IsIATAFlightNumber check if the string is an IATA flight number: a 2 character airline designator
+// (letters or digits, but not two digits), an optional space, 1 to 4 digits and an optional suffix letter.
+func IsIATAFlightNumber(str string) bool {
+	return rxIATAFlightNumber.MatchString(str)
+}
+
+// IsIATAAirportCode check if the string has the format of an IATA airport code, i.e. 3 uppercase letters.
+// Whether the code is actually assigned to an airport is not checked.
+func IsIATAAirportCode(str string) bool {
+	return rxIATAAirportCode.MatchString(str)
+}
+
+// IsHTTPHeaderName check if the string is a valid HTTP header field name, i.e. a token as defined by RFC 7230:
+// one or more visible ASCII characters excluding separators such as "(", ")", ":", "/" and spaces.
+func IsHTTPHeaderName(str string) bool {
+	return rxHTTPHeaderName.MatchString(str)
+}
+
+// IsAcceptLanguage check if the string is a valid HTTP Accept-Language header value: a comma-separated
+// list of language ranges (e.g. "en-US" or "*"), each with an optional quality value, e.g. "en-US,en;q=0.9,*;q=0.5".
+func IsAcceptLanguage(str string) bool {
+	if str == "" {
+		return false
+	}
+	for _, lr := range strings.Split(str, ",") {
+		if !rxAcceptLanguageRange.MatchString(strings.Trim(lr, " \t")) {
+			return false
+		}
+	}
+	return true
+}
+
 // ByteLength check string's length
 func ByteLength(str string, params ...string) bool {
 	if len(params) == 2 {
@@ -968,7 +2166,292 @@ func IsIn(str string, params ...string) bool {
 	return false
 }
 
-func checkRequired(v reflect.Value, t reflect.StructField, options tagOptionsMap) (bool, error) {
+// IsOTPCode check if the string is a numeric one-time password (TOTP/HOTP) code.
+// The optional param is the number of digits and defaults to 6.
+func IsOTPCode(str string, params ...string) bool {
+	digits := int64(6)
+	if len(params) > 0 && params[len(params)-1] != "" {
+		digits, _ = ToInt(params[len(params)-1])
+	}
+	return int64(len(str)) == digits && rxNumeric.MatchString(str)
+}
+
+// IsPasswordContainsCharsets check if the string contains at least one character of each of the
+// given character classes, passed as a pipe separated list (e.g. "upper|lower|digit|special").
+// Supported classes are upper (A-Z), lower (a-z), digit (0-9) and special (printable ASCII
+// that is neither a letter nor a digit).
+func IsPasswordContainsCharsets(str string, params ...string) bool {
+	classes := strings.Split(strings.Join(params, "|"), "|")
+	for _, class := range classes {
+		var inClass func(r rune) bool
+		switch class {
+		case "upper":
+			inClass = func(r rune) bool { return r >= 'A' && r <= 'Z' }
+		case "lower":
+			inClass = func(r rune) bool { return r >= 'a' && r <= 'z' }
+		case "digit":
+			inClass = func(r rune) bool { return r >= '0' && r <= '9' }
+		case "special":
+			inClass = func(r rune) bool {
+				return r > ' ' && r <= '~' && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+			}
+		default:
+			return false
+		}
+		if strings.IndexFunc(str, inClass) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsNoConsecutiveRepeatedChars check if no character of the string is repeated
+// consecutively the given number of times or more, e.g. "aaab" fails with a limit of 3.
+func IsNoConsecutiveRepeatedChars(str string, params ...string) bool {
+	if len(params) != 1 {
+		return false
+	}
+	limit, err := ToInt(params[0])
+	if err != nil || limit < 1 {
+		return false
+	}
+	var prev rune
+	var run int64
+	for i, c := range str {
+		if i > 0 && c == prev {
+			run++
+		} else {
+			run = 1
+		}
+		if run >= limit {
+			return false
+		}
+		prev = c
+	}
+	return true
+}
+
+// IsIPInRange check if the string is an IP address inside the CIDR network given as the param,
+// e.g. "10.1.2.3" is in range of "10.0.0.0/8".
+func IsIPInRange(str string, params ...string) bool {
+	if len(params) != 1 {
+		return false
+	}
+	ip := net.ParseIP(str)
+	if ip == nil {
+		return false
+	}
+	_, network, err := net.ParseCIDR(params[0])
+	if err != nil {
+		return false
+	}
+	return network.Contains(ip)
+}
+
+// IsUsername check if the string is a username of the given min and max length made of letters, digits
+// and underscores, with no leading, trailing or consecutive underscores, e.g. `valid:"username(3|20)"`.
+func IsUsername(str string, params ...string) bool {
+	if len(params) != 2 {
+		return false
+	}
+	min, err := ToInt(params[0])
+	if err != nil {
+		return false
+	}
+	max, err := ToInt(params[1])
+	if err != nil {
+		return false
+	}
+	return len(str) >= int(min) && len(str) <= int(max) && rxUsername.MatchString(str)
+}
+
+// IsDataURIWithMIME check if the string is a base64 encoded data URI with the MIME type given as the param
+// (compared case-insensitively), e.g. `valid:"datauriwithmime(image/png)"`.
+func IsDataURIWithMIME(str string, params ...string) bool {
+	if len(params) != 1 || !strings.Contains(str, ",") || !IsDataURI(str) {
+		return false
+	}
+	mime := strings.TrimPrefix(str[:strings.Index(str, ",")], "data:")
+	if i := strings.Index(mime, ";"); i >= 0 {
+		mime = mime[:i]
+	}
+	return strings.EqualFold(mime, strings.TrimSpace(params[0]))
+}
+
+// IsHexLength check if the string is a hexadecimal string of exactly the given number of characters,
+// e.g. `valid:"hexlength(64)"` for a SHA-256 hash.
+func IsHexLength(str string, params ...string) bool {
+	if len(params) != 1 {
+		return false
+	}
+	length, err := ToInt(params[0])
+	if err != nil {
+		return false
+	}
+	return int64(len(str)) == length && IsHexadecimal(str)
+}
+
+// IsDatabaseTableName check if the string can be used as an unquoted table name in the SQL dialect given as
+// the param: postgres (or postgresql), mysql or sqlite. Each dialect has its own rules for the allowed
+// characters and length, and reserved words are rejected, e.g. `valid:"dbtablename(postgres)"`.
+func IsDatabaseTableName(str string, params ...string) bool {
+	if len(params) != 1 {
+		return false
+	}
+	name := strings.ToLower(str)
+	switch strings.ToLower(params[0]) {
+	case "postgres", "postgresql":
+		_, reserved := postgresReservedWords[name]
+		return !reserved && IsPostgresIdentifier(str)
+	case "mysql":
+		// at most 64 characters, may start with a digit but not consist solely of digits
+		_, reserved := mysqlReservedWords[name]
+		return !reserved && utf8.RuneCountInString(str) <= 64 && rxMySQLIdentifier.MatchString(str) && !rxNumeric.MatchString(str)
+	case "sqlite":
+		// names beginning with "sqlite_" are reserved for internal use
+		_, reserved := sqliteKeywords[name]
+		return !reserved && rxSQLiteIdentifier.MatchString(str) && !strings.HasPrefix(name, "sqlite_")
+	}
+	return false
+}
+
+// IsAgeRange check if the string is an RFC3339 birth date (2006-01-02) or timestamp of someone whose age
+// in full years is within the given min and max, e.g. `valid:"agerange(18|120)"`.
+func IsAgeRange(str string, params ...string) bool {
+	if len(params) != 2 {
+		return false
+	}
+	min, err := ToInt(params[0])
+	if err != nil {
+		return false
+	}
+	max, err := ToInt(params[1])
+	if err != nil {
+		return false
+	}
+	age, ok := ageFromBirthDate(str)
+	return ok && int64(age) >= min && int64(age) <= max
+}
+
+// IsMinAge check if the string is an RFC3339 birth date (2006-01-02) or timestamp of someone who is at least
+// the given number of years old today, e.g. `valid:"minage(18)"`.
+func IsMinAge(str string, params ...string) bool {
+	if len(params) != 1 {
+		return false
+	}
+	min, err := ToInt(params[0])
+	if err != nil {
+		return false
+	}
+	age, ok := ageFromBirthDate(str)
+	return ok && int64(age) >= min
+}
+
+// IsMaxAge check if the string is an RFC3339 birth date (2006-01-02) or timestamp of someone who is at most
+// the given number of years old today, e.g. `valid:"maxage(65)"`.
+func IsMaxAge(str string, params ...string) bool {
+	if len(params) != 1 {
+		return false
+	}
+	max, err := ToInt(params[0])
+	if err != nil {
+		return false
+	}
+	age, ok := ageFromBirthDate(str)
+	return ok && int64(age) <= max
+}
+
+// IsUnicodeBlock check if all characters of the string belong to the Unicode script given as the param,
+// e.g. `valid:"unicodeblock(Hiragana)"`. The names are those of unicode.Scripts; note that spaces, digits
+// and punctuation belong to the "Common" script.
+func IsUnicodeBlock(str string, params ...string) bool {
+	if len(params) != 1 {
+		return false
+	}
+	table, ok := unicode.Scripts[params[0]]
+	if !ok {
+		return false
+	}
+	for _, c := range str {
+		if !unicode.Is(table, c) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsURLWithScheme check if the string is a full URL (see IsFullURL) whose scheme is one of the pipe separated
+// schemes given as the param (compared case-insensitively), e.g. `valid:"urlwithscheme(https)"` or
+// `valid:"urlwithscheme(http|https)"`.
+func IsURLWithScheme(str string, params ...string) bool {
+	if len(params) != 1 || !IsFullURL(str) {
+		return false
+	}
+	u, _ := url.Parse(str)
+	for _, scheme := range strings.Split(params[0], "|") {
+		if strings.EqualFold(u.Scheme, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPasswordPolicy check if the string satisfies every constraint of the password policy given as the param:
+// a list of key:value pairs separated by "|" (or "," when called directly), e.g.
+// `valid:"pwpolicy(minlen:8|maxlen:64|upper:1|lower:1|digit:1|special:1)"`.
+// minlen and maxlen bound the length in characters, while upper, lower, digit and special set the
+// minimum number of characters of each class (as defined by IsPasswordContainsCharsets).
+// A policy with an unknown key or a malformed value never matches.
+func IsPasswordPolicy(str string, params ...string) bool {
+	if len(params) != 1 || params[0] == "" {
+		return false
+	}
+	var upper, lower, digit, special int
+	for _, r := range str {
+		switch {
+		case r >= 'A' && r <= 'Z':
+			upper++
+		case r >= 'a' && r <= 'z':
+			lower++
+		case r >= '0' && r <= '9':
+			digit++
+		case r > ' ' && r <= '~':
+			special++
+		}
+	}
+	length := utf8.RuneCountInString(str)
+	for _, rule := range strings.FieldsFunc(params[0], func(r rune) bool { return r == '|' || r == ',' }) {
+		kv := strings.SplitN(strings.TrimSpace(rule), ":", 2)
+		if len(kv) != 2 {
+			return false
+		}
+		value, err := strconv.Atoi(strings.TrimSpace(kv[1]))
+		if err != nil || value < 0 {
+			return false
+		}
+		var ok bool
+		switch strings.TrimSpace(kv[0]) {
+		case "minlen":
+			ok = length >= value
+		case "maxlen":
+			ok = length <= value
+		case "upper":
+			ok = upper >= value
+		case "lower":
+			ok = lower >= value
+		case "digit":
+			ok = digit >= value
+		case "special":
+			ok = special >= value
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func checkRequired(v reflect.Value, t reflect.StructField, o reflect.Value, options tagOptionsMap) (bool, error) {
 	if nilPtrAllowedByRequired {
 		k := v.Kind()
 		if (k == reflect.Ptr || k == reflect.Interface) && v.IsNil() {
@@ -976,18 +2459,102 @@ func checkRequired(v reflect.Value, t reflect.StructField, options tagOptionsMap
 		}
 	}
 
+	// required_with_all(A|B) and required_without_all(A|B) make the field required depending on
+	// whether all the listed sibling fields are set or all of them are zero
+	conditional := false
+	for _, key := range options.orderedKeys() {
+		ps := requiredIfRegexp.FindStringSubmatch(key)
+		if len(ps) == 0 {
+			continue
+		}
+		conditional = true
+		if !allFieldsMatch(o, strings.Split(ps[2], "|"), ps[1] == "required_with_all") {
+			continue
+		}
+		if len(options[key].customErrorMessage) > 0 {
+			return false, Error{t.Name, fmt.Errorf(options[key].customErrorMessage), true, ps[1], []string{}}
+		}
+		return false, Error{t.Name, fmt.Errorf("non zero value required"), false, ps[1], []string{}}
+	}
+
 	if requiredOption, isRequired := options["required"]; isRequired {
 		if len(requiredOption.customErrorMessage) > 0 {
 			return false, Error{t.Name, fmt.Errorf(requiredOption.customErrorMessage), true, "required", []string{}}
 		}
 		return false, Error{t.Name, fmt.Errorf("non zero value required"), false, "required", []string{}}
-	} else if _, isOptional := options["optional"]; fieldsRequiredByDefault && !isOptional {
+	} else if _, isOptional := options["optional"]; fieldsRequiredByDefault && !isOptional && !conditional {
 		return false, Error{t.Name, fmt.Errorf("Missing required field"), false, "required", []string{}}
 	}
 	// not required and empty is valid
 	return true, nil
 }
 
+// compareToField compares v with the named field of the struct o, returning -1, 0 or +1 if v is less than,
+// equal to or greater than the field. Numbers are compared by value and strings lexicographically;
+// ok is false if the field doesn't exist or the values aren't comparable.
+func compareToField(v reflect.Value, o reflect.Value, name string) (cmp int, ok bool) {
+	if o.Kind() != reflect.Struct {
+		return 0, false
+	}
+	f := o.FieldByName(name)
+	if !f.IsValid() {
+		return 0, false
+	}
+	if f.Kind() == reflect.Ptr || f.Kind() == reflect.Interface {
+		if f.IsNil() {
+			return 0, false
+		}
+		f = f.Elem()
+	}
+	if v.Kind() == reflect.String || f.Kind() == reflect.String {
+		if v.Kind() != f.Kind() {
+			return 0, false
+		}
+		return strings.Compare(v.String(), f.String()), true
+	}
+	a, okA := toFloatValue(v)
+	b, okB := toFloatValue(f)
+	if !okA || !okB {
+		return 0, false
+	}
+	switch {
+	case a < b:
+		return -1, true
+	case a > b:
+		return 1, true
+	}
+	return 0, true
+}
+
+func toFloatValue(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+// allFieldsMatch reports whether all the named fields of the struct o are set (or, if set is false, all are zero).
+// Fields which don't exist are considered zero.
+func allFieldsMatch(o reflect.Value, names []string, set bool) bool {
+	for _, name := range names {
+		isSet := false
+		if o.Kind() == reflect.Struct {
+			if f := o.FieldByName(strings.TrimSpace(name)); f.IsValid() {
+				isSet = !isEmptyValue(f)
+			}
+		}
+		if isSet != set {
+			return false
+		}
+	}
+	return true
+}
+
 func typeCheck(v reflect.Value, t reflect.StructField, o reflect.Value, options tagOptionsMap) (isValid bool, resultErr error) {
 	if !v.IsValid() {
 		return false, nil
@@ -1016,7 +2583,7 @@ func typeCheck(v reflect.Value, t reflect.StructField, o reflect.Value, options
 
 	if isEmptyValue(v) {
 		// an empty value is not validated, check only required
-		isValid, resultErr = checkRequired(v, t, options)
+		isValid, resultErr = checkRequired(v, t, o, options)
 		for key := range options {
 			delete(options, key)
 		}
@@ -1027,15 +2594,18 @@ func typeCheck(v reflect.Value, t reflect.StructField, o reflect.Value, options
 	optionsOrder := options.orderedKeys()
 	for _, validatorName := range optionsOrder {
 		validatorStruct := options[validatorName]
-		if validatefunc, ok := CustomTypeTagMap.Get(validatorName); ok {
+		if validatefunc, ok := CustomTypeTagMap.GetWithError(validatorName); ok {
 			delete(options, validatorName)
 
-			if result := validatefunc(v.Interface(), o.Interface()); !result {
+			if result, err := validatefunc(v.Interface(), o.Interface()); !result || err != nil {
 				if len(validatorStruct.customErrorMessage) > 0 {
 					customTypeErrors = append(customTypeErrors, Error{Name: t.Name, Err: TruncatingErrorf(validatorStruct.customErrorMessage, fmt.Sprint(v), validatorName), CustomErrorMessageExists: true, Validator: stripParams(validatorName)})
 					continue
 				}
-				customTypeErrors = append(customTypeErrors, Error{Name: t.Name, Err: fmt.Errorf("%s does not validate as %s", fmt.Sprint(v), validatorName), CustomErrorMessageExists: false, Validator: stripParams(validatorName)})
+				if err == nil {
+					err = fmt.Errorf("%s does not validate as %s", fmt.Sprint(v), validatorName)
+				}
+				customTypeErrors = append(customTypeErrors, Error{Name: t.Name, Err: err, CustomErrorMessageExists: false, Validator: stripParams(validatorName)})
 			}
 		}
 	}
@@ -1049,6 +2619,11 @@ func typeCheck(v reflect.Value, t reflect.StructField, o reflect.Value, options
 		defer func() {
 			delete(options, "optional")
 			delete(options, "required")
+			for key := range options {
+				if requiredIfRegexp.MatchString(key) {
+					delete(options, key)
+				}
+			}
 
 			if isValid && resultErr == nil && len(options) != 0 {
 				optionsOrder := options.orderedKeys()
@@ -1081,6 +2656,25 @@ func typeCheck(v reflect.Value, t reflect.StructField, o reflect.Value, options
 				negate = true
 			}
 
+			// Check for comparisons with another field of the struct
+			if ps := fieldCompareRegexp.FindStringSubmatch(validator); len(ps) > 0 {
+				delete(options, validatorSpec)
+
+				field := fmt.Sprint(v)
+				cmp, ok := compareToField(v, o, ps[2])
+				result := ok && (ps[1] == "gtefield" && cmp >= 0 || ps[1] == "ltefield" && cmp <= 0)
+				if (!result && !negate) || (result && negate) {
+					if customMsgExists {
+						return false, Error{t.Name, TruncatingErrorf(validatorStruct.customErrorMessage, field, validator), customMsgExists, stripParams(validatorSpec), []string{}}
+					}
+					if negate {
+						return false, Error{t.Name, fmt.Errorf("%s does validate as %s", field, validator), customMsgExists, stripParams(validatorSpec), []string{}}
+					}
+					return false, Error{t.Name, fmt.Errorf("%s does not validate as %s", field, validator), customMsgExists, stripParams(validatorSpec), []string{}}
+				}
+				continue
+			}
+
 			// Check for param validators
 			for key, value := range ParamTagRegexMap {
 				ps := value.FindStringSubmatch(validator)
@@ -1254,7 +2848,12 @@ func ErrorsByField(e error) map[string]string {
 
 	switch e.(type) {
 	case Error:
-		m[e.(Error).Name] = e.(Error).Err.Error()
+		name := e.(Error).Name
+		if name == "" {
+			// struct-level errors (see RegisterStructValidationFn) are keyed by the path of the struct
+			name = strings.Join(e.(Error).Path, ".")
+		}
+		m[name] = e.(Error).Err.Error()
 	case Errors:
 		for _, item := range e.(Errors).Errors() {
 			n := ErrorsByField(item)